@@ -0,0 +1,76 @@
+package nit
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// BlocksPolicy represents how many blocks of a section are allowed per
+	// file.
+	BlocksPolicy uint8
+)
+
+const (
+	// BlocksPolicyOne indicates only one block is allowed.
+	BlocksPolicyOne BlocksPolicy = iota
+
+	// BlocksPolicyMany indicates multiple blocks are allowed, each one is
+	// validated independently.
+	BlocksPolicyMany
+
+	// BlocksPolicyManySorted indicates multiple blocks are allowed, they are
+	// validated as one block using `//-` to separate them.
+	BlocksPolicyManySorted
+)
+
+// DefaultBlocksPolicies returns the default policies: multiple `imports` and
+// `const` blocks, one `type` and `var` block.
+func DefaultBlocksPolicies() map[FileSection]BlocksPolicy {
+	return map[FileSection]BlocksPolicy{
+		FileSectionImports: BlocksPolicyMany,
+		FileSectionTypes:   BlocksPolicyOne,
+		FileSectionConsts:  BlocksPolicyMany,
+		FileSectionVars:    BlocksPolicyOne,
+	}
+}
+
+// NewBlocksPolicies returns the policies defined in the received comma
+// separated value, using the format `section=policy`, where section is one of
+// imports, types, consts or vars; and policy one of one, many or many-sorted.
+// Sections not included use the default policy.
+func NewBlocksPolicies(s string) (map[FileSection]BlocksPolicy, error) {
+	policies := map[string]BlocksPolicy{
+		"many":        BlocksPolicyMany,
+		"many-sorted": BlocksPolicyManySorted,
+		"one":         BlocksPolicyOne,
+	}
+
+	res := DefaultBlocksPolicies()
+
+	if s == "" {
+		return res, nil
+	}
+
+	for _, value := range strings.Split(s, ",") {
+		values := strings.SplitN(strings.TrimSpace(value), "=", 2)
+		if len(values) != 2 {
+			return nil, errors.Errorf("invalid blocks policy: %s", value)
+		}
+
+		section, ok := newDeclFileSection(values[0])
+		if !ok {
+			return nil, errors.Errorf("invalid blocks policy section: %s", values[0])
+		}
+
+		policy, ok := policies[values[1]]
+		if !ok {
+			return nil, errors.Errorf("invalid blocks policy value: %s", values[1])
+		}
+
+		res[section] = policy
+	}
+
+	return res, nil
+}
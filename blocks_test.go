@@ -0,0 +1,147 @@
+package nit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestNewBlocksPolicies(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		input         string
+		expected      map[nit.FileSection]nit.BlocksPolicy
+		expectedError bool
+	}{
+		{
+			"OK: default",
+			"",
+			nit.DefaultBlocksPolicies(),
+			false,
+		},
+		{
+			"OK",
+			"imports=one, types=many-sorted,vars=many",
+			map[nit.FileSection]nit.BlocksPolicy{
+				nit.FileSectionImports: nit.BlocksPolicyOne,
+				nit.FileSectionTypes:   nit.BlocksPolicyManySorted,
+				nit.FileSectionConsts:  nit.BlocksPolicyMany,
+				nit.FileSectionVars:    nit.BlocksPolicyMany,
+			},
+			false,
+		},
+		{
+			"Error: format",
+			"types",
+			nil,
+			true,
+		},
+		{
+			"Error: section",
+			"funcs=one",
+			nil,
+			true,
+		},
+		{
+			"Error: policy",
+			"types=two",
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, err := nit.NewBlocksPolicies(tt.input)
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+
+			if !cmp.Equal(tt.expected, actual) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+func TestNitpicker_ValidateBlocks(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		policies      string
+		expectedError bool
+	}{
+		{
+			"OK: imports",
+			"blocks_imports.go",
+			"",
+			false,
+		},
+		{
+			"OK: vars",
+			"blocks_vars.go",
+			"vars=many",
+			false,
+		},
+		{
+			"OK: types",
+			"blocks_types.go",
+			"types=many",
+			false,
+		},
+		{
+			"OK: types sorted",
+			"blocks_types_sorted.go",
+			"types=many-sorted",
+			false,
+		},
+		{
+			"Error: imports",
+			"blocks_imports.go",
+			"imports=one",
+			true,
+		},
+		{
+			"Error: imports duplicated",
+			"blocks_imports_duplicated.go",
+			"",
+			true,
+		},
+		{
+			"Error: vars",
+			"blocks_vars.go",
+			"",
+			true,
+		},
+		{
+			"Error: types",
+			"blocks_types.go",
+			"",
+			true,
+		},
+		{
+			"Error: types sorted",
+			"blocks_types.go",
+			"types=many-sorted",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			policies, err := nit.NewBlocksPolicies(tt.policies)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			n := nit.Nitpicker{BlocksPolicies: policies}
+
+			if err := n.Validate(filepath.Join("testdata", tt.filename)); tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
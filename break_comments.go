@@ -12,6 +12,7 @@ type (
 	BreakComments struct {
 		index         int
 		comments      []int
+		nested        []int
 		generatedFile bool
 		nolintNit     bool
 	}
@@ -23,10 +24,48 @@ const (
 	nitLinter     = "^//nolint:nit$"
 )
 
-// NewBreakComments returns all the valid break-like comments.
+// NewBreakComments returns all the valid break-like comments, the ones in the
+// first two columns are considered left most; use NewFileBreakComments for
+// detecting the ones in types and functions bodies regardless of their column.
 func NewBreakComments(fset *token.FileSet, comments []*ast.CommentGroup) *BreakComments {
+	return newBreakComments(fset, comments, nil)
+}
+
+// NewFileBreakComments returns all the valid break-like comments in the file,
+// the ones in the first two columns are considered left most unless they are
+// declared in types or functions bodies.
+func NewFileBreakComments(fset *token.FileSet, f *ast.File) *BreakComments {
+	var bodies [][2]token.Pos
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch t := n.(type) {
+		case *ast.BlockStmt:
+			bodies = append(bodies, [2]token.Pos{t.Lbrace, t.Rbrace})
+		case *ast.FieldList:
+			if t.Opening.IsValid() {
+				bodies = append(bodies, [2]token.Pos{t.Opening, t.Closing})
+			}
+		}
+
+		return true
+	})
+
+	return newBreakComments(fset, f.Comments, bodies)
+}
+
+func newBreakComments(fset *token.FileSet, comments []*ast.CommentGroup, bodies [][2]token.Pos) *BreakComments {
 	r := BreakComments{}
 
+	inBody := func(pos token.Pos) bool {
+		for _, b := range bodies {
+			if pos > b[0] && pos < b[1] {
+				return true
+			}
+		}
+
+		return false
+	}
+
 	regen, _ := regexp.Compile(generatedFile)
 	renit, _ := regexp.Compile(nitLinter)
 
@@ -42,8 +81,10 @@ func NewBreakComments(fset *token.FileSet, comments []*ast.CommentGroup) *BreakC
 
 			if strings.HasPrefix(c1.Text, breakComment) {
 				position := fset.PositionFor(c1.Pos(), false)
-				if position.Column == 1 || position.Column == 2 { // left most either nested or not nested group declarations
+				if (position.Column == 1 || position.Column == 2) && !inBody(c1.Pos()) { // left most either nested or not nested group declarations
 					r.comments = append(r.comments, position.Line)
+				} else {
+					r.nested = append(r.nested, position.Line)
 				}
 			}
 		}
@@ -64,6 +105,14 @@ func (c *BreakComments) HasNoLintNit() bool {
 	return c.nolintNit
 }
 
+// Lines returns the lines of the left most break-like comments.
+func (c *BreakComments) Lines() []int {
+	dst := make([]int, len(c.comments))
+	copy(dst, c.comments)
+
+	return dst
+}
+
 // Moves current line cursor to the received line.
 func (c *BreakComments) MoveTo(line int) {
 	if c.index >= len(c.comments) {
@@ -78,6 +127,20 @@ func (c *BreakComments) MoveTo(line int) {
 	}
 }
 
+// Nested returns the lines, between from and to inclusive, of the break-like
+// comments that are not left most, for example the ones used in interfaces.
+func (c *BreakComments) Nested(from, to int) []int {
+	var res []int
+
+	for _, v := range c.nested {
+		if v >= from && v <= to {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
 // Returns the next break line if any, when no more left it returns -1.
 func (c *BreakComments) Next() int {
 	if c.index >= len(c.comments) {
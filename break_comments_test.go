@@ -11,6 +11,7 @@ import (
 func TestBreakComments(t *testing.T) {
 	type expected struct {
 		result        []int
+		nested        []int
 		generatedCode bool
 		noLintNit     bool
 	}
@@ -23,7 +24,12 @@ func TestBreakComments(t *testing.T) {
 		{
 			"OK",
 			"break_comments1.go",
-			expected{result: []int{8, 13, 19}},
+			expected{result: []int{8, 13, 19}, nested: []int{6, 16}},
+		},
+		{
+			"OK: unparenthesized types",
+			"break_comments4.go",
+			expected{result: []int{15}, nested: []int{5, 11, 18}},
 		},
 		{
 			"OK: code generated",
@@ -43,7 +49,7 @@ func TestBreakComments(t *testing.T) {
 
 			var actual []int
 
-			bc := nit.NewBreakComments(fset, f.Comments)
+			bc := nit.NewFileBreakComments(fset, f)
 			for _, v := range tt.expected.result {
 				bc.MoveTo(v)
 				v = bc.Next()
@@ -57,6 +63,10 @@ func TestBreakComments(t *testing.T) {
 				ts.Errorf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
 			}
 
+			if nested := bc.Nested(1, 100); !cmp.Equal(tt.expected.nested, nested) {
+				ts.Errorf("expected nested values do not match: %s", cmp.Diff(tt.expected.nested, nested))
+			}
+
 			if tt.expected.generatedCode != bc.HasGeneratedCode() {
 				ts.Errorf("expected %t, got %t", tt.expected.generatedCode, bc.HasGeneratedCode())
 			}
@@ -1,16 +1,23 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"go/build"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/MarioCarrion/nit"
 )
 
+type (
+	aliasesFlag map[string]string
+)
+
 //nolint: gochecknoglobals
 var (
 	commit  = "none" //-
@@ -18,21 +25,208 @@ var (
 	version = "dev"
 )
 
+// explain prints the explanation of the rule matching the received ID or
+// error, it returns the exit code.
+func explain(args []string) int {
+	if len(args) == 0 {
+		fmt.Println("missing `rule` argument, use `rules` for listing them.")
+		return 1
+	}
+
+	r, ok := nit.FindRule(strings.Join(args, " "))
+	if !ok {
+		fmt.Printf("unknown rule %q, use `rules` for listing them.\n", strings.Join(args, " "))
+		return 1
+	}
+
+	fmt.Print(r)
+
+	return 0
+}
+
+// graph prints the diagram of the file sections or `imports` groups state
+// machine, it returns the exit code.
+func graph(args []string) int {
+	fs := flag.NewFlagSet("graph", flag.ExitOnError)
+	format := fs.String("format", "dot", "diagram format: dot or mermaid")
+	machine := fs.String("machine", "sections", "state machine: sections or imports")
+	importsGroups := fs.String("imports-groups", "standard,external,local", "imports groups in order, used by the imports machine")
+	blocks := fs.String("blocks", "", "blocks allowed per file as `section=policy`, used by the sections machine")
+
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	f, err := nit.NewGraphFormat(*format)
+	if err != nil {
+		fmt.Println(err)
+		fs.Usage()
+
+		return 1
+	}
+
+	var g *nit.Graph
+
+	switch *machine {
+	case "imports":
+		groups, gerr := nit.NewImportsGroups(*importsGroups)
+		if gerr != nil {
+			fmt.Println(gerr)
+			fs.Usage()
+
+			return 1
+		}
+
+		g, err = nit.NewImportsGroupsGraph(groups)
+	case "sections":
+		policies, perr := nit.NewBlocksPolicies(*blocks)
+		if perr != nil {
+			fmt.Println(perr)
+			fs.Usage()
+
+			return 1
+		}
+
+		g, err = nit.NewFileSectionsGraph(policies)
+	default:
+		err = fmt.Errorf("invalid state machine: %s", *machine)
+	}
+
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	fmt.Print(g.Render(f))
+
+	return 0
+}
+
+// importDir returns the directory of the import path, paths in the current
+// module are resolved relative to its directory, even when they do not include
+// Go files.
+func importDir(path string) (string, error) {
+	if modPath, modDir := modulePath("."); modPath != "" && (path == modPath || strings.HasPrefix(path, modPath+"/")) {
+		return filepath.Join(modDir, filepath.FromSlash(strings.TrimPrefix(path, modPath))), nil
+	}
+
+	p, err := build.Import(path, ".", build.FindOnly)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Dir, nil
+}
+
+// importPackage imports the package, absolute paths are imported as
+// directories.
+func importPackage(pkg string) (*build.Package, error) {
+	if filepath.IsAbs(pkg) {
+		return build.ImportDir(pkg, 0)
+	}
+
+	return build.Import(pkg, ".", 0)
+}
+
+// importPath returns the import path of the package, the ones imported using
+// a relative path are resolved using the module including them.
+func importPath(p *build.Package) string {
+	if !build.IsLocalImport(p.ImportPath) {
+		return p.ImportPath
+	}
+
+	modPath, modDir := modulePath(p.Dir)
+	if modPath == "" {
+		return p.ImportPath
+	}
+
+	dir, err := filepath.Abs(p.Dir)
+	if err != nil {
+		return p.ImportPath
+	}
+
+	rel, err := filepath.Rel(modDir, dir)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return p.ImportPath
+	}
+
+	if rel == "." {
+		return modPath
+	}
+
+	return modPath + "/" + filepath.ToSlash(rel)
+}
+
 //nolint: funlen
 func main() {
 	//nolint: errcheck
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "\n%s [packages]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "%s outline [-json] [files]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "%s rules [-json]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "%s explain [rule or error]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "%s graph [-format dot|mermaid] [-machine sections|imports] [-imports-groups groups]\n", os.Args[0])
 		flag.PrintDefaults()
 	}
 	//-
 
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "explain":
+			os.Exit(explain(os.Args[2:]))
+		case "graph":
+			os.Exit(graph(os.Args[2:]))
+		case "outline":
+			os.Exit(outline(os.Args[2:]))
+		case "rules":
+			os.Exit(rules(os.Args[2:]))
+		}
+	}
+
 	localPkg := flag.String("pkg", "", "local package")
+	importsAliases := flag.Bool("imports-aliases", false, "enforce imports aliases conventions")
+	aliases := aliasesFlag{}
+	flag.Var(aliases, "imports-alias", "required `path=alias` for an import, implies -imports-aliases, can be repeated")
+	importsGroups := flag.String("imports-groups", "standard,external,local", "imports groups in order: standard, external, local, module, blank, dot, cgo and prefix(<path>)")
 	skipGenerated := flag.Bool("skip-generated", false, "skip generated files")
 	nolint := flag.Bool("nolint", false, "enable nolint directive")
 	includeTests := flag.Bool("include-tests", false, "include test files")
+	constructorsFirst := flag.Bool("constructors-first", false, "require constructors first, sorted as their types")
+	sortedInterfaces := flag.Bool("sorted-interfaces", false, "require sorted interface methods, embedded interfaces first")
+	fixInterfaces := flag.Bool("fix-interfaces", false, "rewrite the interfaces sorting their methods, within each //- subgroup, before validating them")
+	structFields := flag.Bool("struct-fields", false, "require struct fields grouped: embedded, exported, then unexported")
+	sortedStructFields := flag.Bool("sorted-struct-fields", false, "require struct fields grouped and sorted")
+	initFirst := flag.Bool("init-first", false, "require init declared before other functions")
+	singleInit := flag.Bool("single-init", false, "allow at most one init function per file")
+	mainPlacement := flag.String("main", "any", "`placement` of main in package main: any, first or last")
+	testMainFirst := flag.Bool("testmain-first", false, "require TestMain declared before other functions")
+	testProfile := flag.Bool("test-profile", false, "use the test files profile: Test, Benchmark, Fuzz, Example, then helpers")
+	sortedTests := flag.Bool("sorted-tests", false, "require sorted Test, Benchmark, Fuzz and Example functions in the test profile")
+	directives := flag.Bool("directives", false, "validate the placement of //go:build, //go:generate and //go:embed directives")
+	generateFile := flag.String("generate-file", "", "only `file` name, in each package, allowed to include //go:generate directives, implies -directives")
+	enums := flag.Bool("enums", false, "require typed iota enums first in the const section, sorted as their types")
+	blocks := flag.String("blocks", "", "blocks allowed per file as `section=policy`, comma separated: imports, types, consts or vars; one, many or many-sorted")
+	parentheses := flag.String("parentheses", "", "specs allowed without parentheses as `section=N`, comma separated: imports, types, consts or vars; implies many blocks for the section when its policy is one")
+	fixParentheses := flag.Bool("fix-parentheses", false, "rewrite the declarations to satisfy -parentheses before validating them")
+	collation := flag.String("collation", "bytewise", "names comparison used for sorting: bytewise, case-insensitive, natural or initialisms")
+	visibility := flag.String("visibility", "", "exported and unexported names grouping as `section=policy`, comma separated: types, consts, vars, funcs or methods; exported-first, unexported-first or ignore")
+	dependencyOrder := flag.Bool("dependency-order", false, "allow consts and vars initialized from previous ones in the block to be declared out of order")
+	declarationOrder := flag.Bool("declaration-order", false, "forbid consts and vars initialized from ones declared after them in the block")
+	receiverNames := flag.Bool("receiver-names", false, "require the same receiver name in all methods of a type, this and self are not allowed")
+	receiverMaxLength := flag.Int("receiver-max-length", 0, "maximum length of receiver names, 0 means no limit")
+	receiverKinds := flag.Bool("receiver-kinds", false, "require all methods of a type to use pointer receivers or value receivers")
+	receiverKindsExceptions := flag.String("receiver-kinds-exceptions", "", "methods, comma separated, allowed to use a different receiver kind")
+	primaryType := flag.Bool("primary-type", false, "allow one exported type maximum per file")
+	filenameType := flag.Bool("filename-type", false, "require files declaring exported types to be named after one of them")
+	typesFiles := flag.String("types-files", "", "only file names, comma separated, allowed to declare exported types in each package")
+	maxLines := flag.Int("max-lines", 0, "maximum number of lines per file, 0 means no limit")
+	maxDecls := flag.Int("max-decls", 0, "maximum number of top-level declarations per file, imports excluded, 0 means no limit")
+	maxTypes := flag.Int("max-types", 0, "maximum number of types per file, 0 means no limit")
+	maxMethods := flag.Int("max-methods", 0, "maximum number of methods per type in each file, 0 means no limit")
+	methodsTypesOrder := flag.Bool("methods-types-order", false, "require methods grouped following the types declaration order")
 	showVersion := flag.Bool("version", false, "prints current version information")
+	watch := flag.Bool("watch", false, "keep running, validating the changed files and printing all the results")
 
 	flag.Parse()
 
@@ -41,46 +235,442 @@ func main() {
 		os.Exit(0)
 	}
 
+	mainPlace, err := nit.NewFuncPlacement(*mainPlacement)
+	if err != nil {
+		fmt.Println(err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	groups, err := nit.NewImportsGroups(*importsGroups)
+	if err != nil {
+		fmt.Println(err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	policies, err := nit.NewBlocksPolicies(*blocks)
+	if err != nil {
+		fmt.Println(err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	names, err := nit.NewCollation(*collation)
+	if err != nil {
+		fmt.Println(err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	visibilities, err := nit.NewVisibilityPolicies(*visibility)
+	if err != nil {
+		fmt.Println(err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	thresholds, err := nit.NewParenthesesThresholds(*parentheses)
+	if err != nil {
+		fmt.Println(err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
 	if len(flag.Args()) == 0 {
 		fmt.Println("missing `pkg` argument.")
 		flag.Usage()
 		os.Exit(1)
 	}
 
-	var failed bool
+	pvalidator := nit.PackageValidator{
+		FilenameType:      *filenameType,
+		NoLint:            *nolint,
+		PrimaryType:       *primaryType,
+		SkipGeneratedFile: *skipGenerated,
+	}
 
-	nitpick := func(files []string) {
-		for _, f := range files {
-			if strings.HasSuffix(f, "_test.go") && !*includeTests {
-				continue
+	if *typesFiles != "" {
+		for _, f := range strings.Split(*typesFiles, ",") {
+			pvalidator.TypesFiles = append(pvalidator.TypesFiles, strings.TrimSpace(f))
+		}
+	}
+
+	var exceptions []string
+	if *receiverKindsExceptions != "" {
+		for _, e := range strings.Split(*receiverKindsExceptions, ",") {
+			exceptions = append(exceptions, strings.TrimSpace(e))
+		}
+	}
+
+	validateFile := func(importPath, modPath, f string) error {
+		if strings.HasSuffix(f, "_test.go") && !*includeTests {
+			return nil
+		}
+
+		if *fixParentheses {
+			fixed, err := nit.FixParentheses(f, thresholds)
+			if err != nil {
+				return err
 			}
 
-			v := nit.Nitpicker{
-				LocalPath:         *localPkg,
-				SkipGeneratedFile: *skipGenerated,
-				NoLint:            *nolint,
+			if fixed {
+				fmt.Printf("fixed: %s\n", f)
 			}
+		}
 
-			if err := v.Validate(f); err != nil {
-				failed = true
+		if *fixInterfaces {
+			fixed, err := nit.FixInterfaces(f, names)
+			if err != nil {
+				return err
+			}
 
-				fmt.Println(err)
+			if fixed {
+				fmt.Printf("fixed: %s\n", f)
 			}
 		}
+
+		v := nit.Nitpicker{
+			LocalPath:               *localPkg,
+			ImportPath:              importPath,
+			ModulePath:              modPath,
+			ImportsGroups:           groups,
+			ImportsAliases:          *importsAliases || len(aliases) > 0,
+			ImportsAliasesMap:       aliases,
+			SkipGeneratedFile:       *skipGenerated,
+			NoLint:                  *nolint,
+			ConstructorsFirst:       *constructorsFirst,
+			MethodsTypesOrder:       *methodsTypesOrder,
+			SortedInterfaces:        *sortedInterfaces,
+			StructFields:            *structFields,
+			SortedStructFields:      *sortedStructFields,
+			InitFirst:               *initFirst,
+			MainPlacement:           mainPlace,
+			TestMainFirst:           *testMainFirst,
+			SingleInit:              *singleInit,
+			TestProfile:             *testProfile,
+			SortedTests:             *sortedTests,
+			Directives:              *directives || *generateFile != "",
+			GenerateFile:            *generateFile,
+			Enums:                   *enums,
+			BlocksPolicies:          policies,
+			ParenthesesThresholds:   thresholds,
+			Collation:               names,
+			VisibilityPolicies:      visibilities,
+			DependencyOrder:         *dependencyOrder,
+			DeclarationOrder:        *declarationOrder,
+			ReceiverNames:           *receiverNames,
+			ReceiverMaxLength:       *receiverMaxLength,
+			ReceiverKinds:           *receiverKinds,
+			ReceiverKindsExceptions: exceptions,
+			MaxLines:                *maxLines,
+			MaxDecls:                *maxDecls,
+			MaxTypes:                *maxTypes,
+			MaxMethods:              *maxMethods,
+		}
+
+		return v.Validate(f)
 	}
 
-	for _, pkg := range flag.Args() {
-		p, err := build.Import(pkg, ".", 0)
+	validatePackage := func(files []string) error {
+		if pvalidator.FilenameType || pvalidator.PrimaryType || len(pvalidator.TypesFiles) > 0 {
+			return pvalidator.Validate(files)
+		}
+
+		return nil
+	}
+
+	w := watcher{
+		out:             os.Stdout,
+		packages:        make(map[string]watchedPackage),
+		results:         make(map[string]string),
+		validateFile:    validateFile,
+		validatePackage: validatePackage,
+	}
+
+	var failed bool
+
+	pkgs, err := packages(flag.Args())
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	for _, pkg := range pkgs {
+		p, err := importPackage(pkg)
 		if err != nil {
 			fmt.Printf("error importing %s: %s\n", pkg, err)
 			os.Exit(1)
 		}
 
+		modPath, _ := modulePath(p.Dir)
+
+		if *watch {
+			w.packages[p.Dir] = watchedPackage{importPath: importPath(p), modPath: modPath}
+			continue
+		}
+
 		gofiles, _ := filepath.Glob(filepath.Join(p.Dir, "*.go"))
-		nitpick(gofiles)
+		for _, f := range gofiles {
+			if err := validateFile(importPath(p), modPath, f); err != nil {
+				failed = true
+
+				fmt.Println(err)
+			}
+		}
+
+		if err := validatePackage(packageFiles(p)); err != nil {
+			failed = true
+
+			fmt.Println(err)
+		}
+	}
+
+	if *watch {
+		dirs := make([]string, 0, len(w.packages))
+		for dir := range w.packages {
+			dirs = append(dirs, dir)
+		}
+
+		changes, err := watchChanges(dirs)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		w.run(changes)
 	}
 
 	if failed {
 		os.Exit(1)
 	}
 }
+
+// modulePath returns the path, and the directory, of the module defined in the
+// closest `go.mod` found walking up from dir.
+func modulePath(dir string) (string, string) {
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+
+	for {
+		content, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(content), "\n") {
+				if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+					return strings.Trim(fields[1], "\""), dir
+				}
+			}
+
+			return "", ""
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ""
+		}
+
+		dir = parent
+	}
+}
+
+// outline prints the sections map of the files, as a text tree or JSON, it
+// returns the exit code.
+func outline(args []string) int {
+	fs := flag.NewFlagSet("outline", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print the outline as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	if fs.NArg() == 0 {
+		fmt.Println("missing `file` argument.")
+		fs.Usage()
+
+		return 1
+	}
+
+	code := 0
+
+	for _, filename := range fs.Args() {
+		o, err := nit.NewFileOutline(filename)
+		if err != nil {
+			fmt.Println(err)
+
+			code = 1
+
+			continue
+		}
+
+		if !*asJSON {
+			fmt.Print(o)
+			continue
+		}
+
+		content, err := json.MarshalIndent(o, "", "  ")
+		if err != nil {
+			fmt.Println(err)
+
+			code = 1
+
+			continue
+		}
+
+		fmt.Println(string(content))
+	}
+
+	return code
+}
+
+// packageFiles returns the Go files of the package satisfying the build
+// constraints, test files are not included.
+func packageFiles(p *build.Package) []string {
+	files := make([]string, 0, len(p.GoFiles)+len(p.CgoFiles))
+
+	for _, names := range [][]string{p.GoFiles, p.CgoFiles} {
+		for _, f := range names {
+			files = append(files, filepath.Join(p.Dir, f))
+		}
+	}
+
+	return files
+}
+
+// packages returns the received packages, expanding the ones ending in `/...`
+// to the directories including Go files satisfying the build constraints found
+// walking them; directories named testdata or vendor, or starting with `.` or
+// `_` are skipped. Patterns using import paths are resolved to their directory
+// and expanded to import paths.
+func packages(args []string) ([]string, error) {
+	var res []string
+
+	for _, arg := range args {
+		if arg != "..." && !strings.HasSuffix(arg, "/...") {
+			res = append(res, arg)
+			continue
+		}
+
+		root := strings.TrimSuffix(strings.TrimSuffix(arg, "..."), "/")
+		if root == "" {
+			root = "."
+		}
+
+		dir, base := root, ""
+
+		if !build.IsLocalImport(root) && !filepath.IsAbs(root) {
+			d, err := importDir(root)
+			if err != nil {
+				return nil, fmt.Errorf("resolving %s failed: %w", arg, err)
+			}
+
+			dir, base = d, root
+		}
+
+		var found bool
+
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !info.IsDir() {
+				return nil
+			}
+
+			name := info.Name()
+			if path != dir && (name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+
+			if _, err := build.ImportDir(path, 0); err != nil {
+				if _, ok := err.(*build.NoGoError); ok {
+					return nil
+				}
+			}
+
+			found = true
+
+			switch {
+			case base != "":
+				rel, err := filepath.Rel(dir, path)
+				if err != nil {
+					return err
+				}
+
+				path = base
+				if rel != "." {
+					path = base + "/" + filepath.ToSlash(rel)
+				}
+			case !filepath.IsAbs(path) && !strings.HasPrefix(path, "."):
+				path = "." + string(filepath.Separator) + path
+			}
+
+			res = append(res, path)
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("expanding %s failed: %w", arg, err)
+		}
+
+		if !found {
+			return nil, fmt.Errorf("no packages found matching %s", arg)
+		}
+	}
+
+	return res, nil
+}
+
+// rules prints the catalogue of rules, as a list or JSON, it returns the exit
+// code.
+func rules(args []string) int {
+	fs := flag.NewFlagSet("rules", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print the rules as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	if *asJSON {
+		content, err := json.MarshalIndent(nit.Rules(), "", "  ")
+		if err != nil {
+			fmt.Println(err)
+			return 1
+		}
+
+		fmt.Println(string(content))
+
+		return 0
+	}
+
+	for _, r := range nit.Rules() {
+		fmt.Printf("%-28s %s\n", r.ID, r.Description)
+	}
+
+	return 0
+}
+
+//-
+
+func (a aliasesFlag) Set(value string) error {
+	values := strings.SplitN(value, "=", 2)
+	if len(values) != 2 || values[0] == "" || values[1] == "" {
+		return fmt.Errorf("invalid alias %q, expected path=alias", value)
+	}
+
+	a[values[0]] = values[1]
+
+	return nil
+}
+
+func (a aliasesFlag) String() string {
+	res := make([]string, 0, len(a))
+	for path, alias := range a {
+		res = append(res, path+"="+alias)
+	}
+
+	sort.Strings(res)
+
+	return strings.Join(res, ",")
+}
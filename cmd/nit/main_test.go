@@ -0,0 +1,155 @@
+package main
+
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestImportPath(t *testing.T) {
+	tests := [...]struct {
+		name     string
+		pkg      string
+		expected string
+	}{
+		{
+			"OK: current directory",
+			".",
+			"github.com/MarioCarrion/nit/cmd/nit",
+		},
+		{
+			"OK: module root",
+			"../..",
+			"github.com/MarioCarrion/nit",
+		},
+		{
+			"OK: import path",
+			"github.com/MarioCarrion/nit",
+			"github.com/MarioCarrion/nit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			p, err := build.Import(tt.pkg, ".", 0)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			if actual := importPath(p); actual != tt.expected {
+				ts.Fatalf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPackageFiles(t *testing.T) {
+	dir := filepath.Join("..", "..", "testdata", "packages")
+
+	p, err := build.ImportDir(dir, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var expected []string
+	for _, f := range []string{"helpers.go", "http_server.go", "item.go", "nolint.go", "types.go", "user.go", "zz_generated.go"} {
+		expected = append(expected, filepath.Join(dir, f))
+	}
+
+	if actual := packageFiles(p); !cmp.Equal(expected, actual) {
+		t.Fatalf("expected values do not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestPackages(t *testing.T) {
+	empty, err := ioutil.TempDir("", "nit")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	defer os.RemoveAll(empty)
+
+	abs, err := filepath.Abs(filepath.Join("testdata", "packages"))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	tests := [...]struct {
+		name          string
+		args          []string
+		expected      []string
+		expectedError bool
+	}{
+		{
+			"OK: not expanded",
+			[]string{"github.com/MarioCarrion/nit", "."},
+			[]string{"github.com/MarioCarrion/nit", "."},
+			false,
+		},
+		{
+			"OK: relative",
+			[]string{"./..."},
+			[]string{"."},
+			false,
+		},
+		{
+			"OK: import path",
+			[]string{"github.com/MarioCarrion/nit/cmd/..."},
+			[]string{"github.com/MarioCarrion/nit/cmd/nit"},
+			false,
+		},
+		{
+			"OK: build constraints",
+			[]string{"./testdata/packages/..."},
+			[]string{"./" + filepath.Join("testdata", "packages", "a")},
+			false,
+		},
+		{
+			"OK: absolute",
+			[]string{filepath.Join(abs, "...")},
+			[]string{filepath.Join(abs, "a")},
+			false,
+		},
+		{
+			"Error: relative not found",
+			[]string{"./missing/..."},
+			nil,
+			true,
+		},
+		{
+			"Error: import path not found",
+			[]string{"github.com/MarioCarrion/nit/missing/..."},
+			nil,
+			true,
+		},
+		{
+			"Error: no packages",
+			[]string{filepath.Join(empty, "...")},
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, err := packages(tt.args)
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+
+			if !cmp.Equal(tt.expected, actual) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+
+			for _, pkg := range actual {
+				if _, err := importPackage(pkg); err != nil {
+					ts.Fatalf("expected no error importing %s, got %s", pkg, err)
+				}
+			}
+		})
+	}
+}
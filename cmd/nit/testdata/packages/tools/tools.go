@@ -0,0 +1,4 @@
+//go:build tools
+// +build tools
+
+package tools
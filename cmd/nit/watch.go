@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	// watchedPackage represents a package validated in watch mode.
+	watchedPackage struct {
+		importPath string
+		modPath    string
+	}
+
+	// watcher re-validates the changed files of the watched packages, keeping
+	// the last result of each file and package.
+	watcher struct {
+		out             io.Writer
+		packages        map[string]watchedPackage
+		results         map[string]string
+		validateFile    func(importPath, modPath, filename string) error
+		validatePackage func(filenames []string) error
+	}
+)
+
+const (
+	// watchDelay defines how long to wait for more changes before
+	// validating, it groups the multiple events generated when saving a file.
+	watchDelay = 100 * time.Millisecond
+)
+
+//-
+
+// print prints the de-duplicated and sorted results.
+func (w *watcher) print() {
+	found := make(map[string]struct{})
+	for _, r := range w.results {
+		found[r] = struct{}{}
+	}
+
+	res := make([]string, 0, len(found))
+	for r := range found {
+		res = append(res, r)
+	}
+
+	sort.Strings(res)
+
+	fmt.Fprintf(w.out, "--- %s, %d issue(s)\n", time.Now().Format("15:04:05"), len(res))
+
+	for _, r := range res {
+		fmt.Fprintln(w.out, r)
+	}
+}
+
+// run validates all the packages and then, until changes is closed, the
+// changed files and their packages; pending changes are validated before
+// returning.
+func (w *watcher) run(changes <-chan string) {
+	pending := make(map[string]struct{})
+
+	for dir := range w.packages {
+		gofiles, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+		for _, f := range gofiles {
+			pending[f] = struct{}{}
+		}
+	}
+
+	w.validate(pending)
+	w.print()
+
+	pending = make(map[string]struct{})
+
+	var timer <-chan time.Time
+
+	for {
+		select {
+		case f, ok := <-changes:
+			if !ok {
+				if len(pending) > 0 {
+					w.validate(pending)
+					w.print()
+				}
+
+				return
+			}
+
+			if _, watched := w.packages[filepath.Dir(f)]; !watched || !strings.HasSuffix(f, ".go") {
+				continue
+			}
+
+			pending[f] = struct{}{}
+			timer = time.After(watchDelay)
+		case <-timer:
+			w.validate(pending)
+			w.print()
+
+			pending = make(map[string]struct{})
+			timer = nil
+		}
+	}
+}
+
+// validate updates the results of the files, and of the packages including
+// them; results of removed files are discarded.
+func (w *watcher) validate(files map[string]struct{}) {
+	dirs := make(map[string]struct{})
+
+	for f := range files {
+		delete(w.results, f)
+
+		dir := filepath.Dir(f)
+		dirs[dir] = struct{}{}
+
+		if _, err := os.Stat(f); err != nil {
+			continue
+		}
+
+		p := w.packages[dir]
+		if err := w.validateFile(p.importPath, p.modPath, f); err != nil {
+			w.results[f] = err.Error()
+		}
+	}
+
+	for dir := range dirs {
+		delete(w.results, dir)
+
+		p, err := build.ImportDir(dir, 0)
+		if err != nil {
+			continue
+		}
+
+		if err := w.validatePackage(packageFiles(p)); err != nil {
+			w.results[dir] = err.Error()
+		}
+	}
+}
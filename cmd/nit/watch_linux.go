@@ -0,0 +1,66 @@
+//go:build linux
+// +build linux
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// watchChanges returns the paths of the files created, written, moved or
+// removed in dirs, using inotify.
+func watchChanges(dirs []string) (<-chan string, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC)
+	if err != nil {
+		return nil, os.NewSyscallError("inotify_init1", err)
+	}
+
+	const mask = syscall.IN_CLOSE_WRITE | syscall.IN_CREATE | syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO
+
+	watches := make(map[int32]string)
+
+	for _, dir := range dirs {
+		wd, err := syscall.InotifyAddWatch(fd, dir, mask)
+		if err != nil {
+			syscall.Close(fd) //nolint: errcheck
+			return nil, os.NewSyscallError("inotify_add_watch "+dir, err)
+		}
+
+		watches[int32(wd)] = dir
+	}
+
+	res := make(chan string)
+
+	go func() {
+		defer close(res)
+
+		buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+
+		for {
+			n, err := syscall.Read(fd, buf)
+			if err == syscall.EINTR {
+				continue
+			}
+
+			if err != nil || n < syscall.SizeofInotifyEvent {
+				return
+			}
+
+			for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+				e := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset])) //nolint: gosec
+				start := offset + syscall.SizeofInotifyEvent
+				offset = start + int(e.Len)
+
+				if dir, ok := watches[e.Wd]; ok && e.Len > 0 {
+					res <- filepath.Join(dir, strings.TrimRight(string(buf[start:offset]), "\x00"))
+				}
+			}
+		}
+	}()
+
+	return res, nil
+}
@@ -0,0 +1,60 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// watchInterval defines how often dirs are checked for changes.
+	watchInterval = 500 * time.Millisecond
+)
+
+// watchChanges returns the paths of the files created, written or removed in
+// dirs, comparing their modification times periodically.
+func watchChanges(dirs []string) (<-chan string, error) {
+	modTimes := func() map[string]time.Time {
+		res := make(map[string]time.Time)
+
+		for _, dir := range dirs {
+			gofiles, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+			for _, f := range gofiles {
+				if info, err := os.Stat(f); err == nil {
+					res[f] = info.ModTime()
+				}
+			}
+		}
+
+		return res
+	}
+
+	res := make(chan string)
+
+	go func() {
+		last := modTimes()
+
+		for range time.Tick(watchInterval) {
+			current := modTimes()
+
+			for f, t := range current {
+				if prev, ok := last[f]; !ok || !prev.Equal(t) {
+					res <- f
+				}
+			}
+
+			for f := range last {
+				if _, ok := current[f]; !ok {
+					res <- f
+				}
+			}
+
+			last = current
+		}
+	}()
+
+	return res, nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWatcher_Run(t *testing.T) {
+	tests := [...]struct {
+		name         string
+		files        []string
+		change       func(dir string) error
+		changed      []string
+		fileErrors   map[string]string
+		packageError func(filenames []string) error
+		initial      []string
+		expected     []string
+	}{
+		{
+			"OK: de-duplicated",
+			[]string{"a.go", "b.go"},
+			nil,
+			nil,
+			map[string]string{"a.go": "issue", "b.go": "issue"},
+			nil,
+			[]string{"issue"},
+			[]string{"issue"},
+		},
+		{
+			"OK: removed file",
+			[]string{"a.go", "b.go"},
+			func(dir string) error {
+				return os.Remove(filepath.Join(dir, "a.go"))
+			},
+			[]string{"a.go"},
+			map[string]string{"a.go": "a issue", "b.go": "b issue"},
+			nil,
+			[]string{"a issue", "b issue"},
+			[]string{"b issue"},
+		},
+		{
+			"OK: package validated",
+			[]string{"a.go"},
+			func(dir string) error {
+				return ioutil.WriteFile(filepath.Join(dir, "b.go"), []byte("package watch\n"), 0600)
+			},
+			[]string{"b.go"},
+			nil,
+			func(filenames []string) error {
+				if len(filenames) > 1 {
+					return errors.New("package issue")
+				}
+
+				return nil
+			},
+			[]string{},
+			[]string{"package issue"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			dir, err := ioutil.TempDir("", "nit")
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			defer os.RemoveAll(dir)
+
+			for _, f := range tt.files {
+				if err := ioutil.WriteFile(filepath.Join(dir, f), []byte("package watch\n"), 0600); err != nil {
+					ts.Fatalf("expected no error, got %s", err)
+				}
+			}
+
+			var out bytes.Buffer
+
+			w := watcher{
+				out:      &out,
+				packages: map[string]watchedPackage{dir: {}},
+				results:  make(map[string]string),
+				validateFile: func(_, _, filename string) error {
+					if e, ok := tt.fileErrors[filepath.Base(filename)]; ok {
+						return errors.New(e)
+					}
+
+					return nil
+				},
+				validatePackage: func(filenames []string) error {
+					if tt.packageError != nil {
+						return tt.packageError(filenames)
+					}
+
+					return nil
+				},
+			}
+
+			changes := make(chan string)
+			done := make(chan struct{})
+
+			go func() {
+				w.run(changes)
+				close(done)
+			}()
+
+			// receiving a non-Go file indicates the packages were validated.
+			changes <- filepath.Join(dir, "README.md")
+
+			if tt.change != nil {
+				if err := tt.change(dir); err != nil {
+					ts.Fatalf("expected no error, got %s", err)
+				}
+			}
+
+			for _, f := range tt.changed {
+				changes <- filepath.Join(dir, f)
+			}
+
+			close(changes)
+			<-done
+
+			blocks := strings.Split(out.String(), "--- ")
+
+			if actual := watchResults(blocks[1]); !cmp.Equal(tt.initial, actual) {
+				ts.Fatalf("expected initial values do not match: %s", cmp.Diff(tt.initial, actual))
+			}
+
+			if actual := watchResults(blocks[len(blocks)-1]); !cmp.Equal(tt.expected, actual) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+// watchResults returns the results printed after the header.
+func watchResults(block string) []string {
+	return strings.Split(strings.TrimSpace(block), "\n")[1:]
+}
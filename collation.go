@@ -0,0 +1,172 @@
+package nit
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Collation represents the comparison used for determining whether names
+	// are sorted.
+	Collation uint8
+)
+
+const (
+	// CollationBytewise compares names byte by byte, `HTTPServer` is sorted
+	// before `Handler` and `item10` before `item2`.
+	CollationBytewise Collation = iota
+
+	// CollationCaseInsensitive compares names ignoring their case, ties are
+	// compared byte by byte.
+	CollationCaseInsensitive
+
+	// CollationNatural compares names byte by byte, except for digits which
+	// are compared by their numeric value, `item2` is sorted before `item10`.
+	CollationNatural
+
+	// CollationInitialisms compares the words of the names ignoring their
+	// case, where initialisms are considered one word, `Handler` is sorted
+	// before `HTTPServer` and `IDList` before `Identity`; digits are compared
+	// as CollationNatural does.
+	CollationInitialisms
+)
+
+// NewCollation returns the collation matching the received value, valid
+// values are: bytewise, case-insensitive, natural and initialisms.
+func NewCollation(s string) (Collation, error) {
+	switch s {
+	case "bytewise":
+		return CollationBytewise, nil
+	case "case-insensitive":
+		return CollationCaseInsensitive, nil
+	case "initialisms":
+		return CollationInitialisms, nil
+	case "natural":
+		return CollationNatural, nil
+	}
+
+	return CollationBytewise, errors.Errorf("invalid collation: %s", s)
+}
+
+func compareNatural(a, b string) int {
+	for a != "" && b != "" {
+		da, db := digitsPrefix(a), digitsPrefix(b)
+
+		if da == "" || db == "" {
+			if a[0] != b[0] {
+				return strings.Compare(a[:1], b[:1])
+			}
+
+			a, b = a[1:], b[1:]
+
+			continue
+		}
+
+		na, nb := strings.TrimLeft(da, "0"), strings.TrimLeft(db, "0")
+		if len(na) != len(nb) {
+			return len(na) - len(nb)
+		}
+
+		if c := strings.Compare(na, nb); c != 0 {
+			return c
+		}
+
+		a, b = a[len(da):], b[len(db):]
+	}
+
+	return len(a) - len(b)
+}
+
+func compareWords(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := compareNatural(strings.ToLower(a[i]), strings.ToLower(b[i])); c != 0 {
+			return c
+		}
+	}
+
+	return len(a) - len(b)
+}
+
+func digitsPrefix(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+
+	return s[:i]
+}
+
+// nameWords splits the name into words, a new word starts after an
+// underscore, with an uppercase letter following a lowercase one, and with
+// the last uppercase letter of an initialism followed by a lowercase one:
+// `HTTPServer` is split into `HTTP` and `Server`.
+func nameWords(name string) []string {
+	var (
+		res   []string
+		runes = []rune(name)
+		start int
+	)
+
+	for i, r := range runes {
+		switch {
+		case r == '_':
+			if i > start {
+				res = append(res, string(runes[start:i]))
+			}
+
+			start = i + 1
+		case i > start && unicode.IsUpper(r):
+			prev := runes[i-1]
+			if !unicode.IsUpper(prev) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				res = append(res, string(runes[start:i]))
+				start = i
+			}
+		}
+	}
+
+	if start < len(runes) {
+		res = append(res, string(runes[start:]))
+	}
+
+	return res
+}
+
+//-
+
+// Less indicates whether the name a is sorted before the name b.
+func (c Collation) Less(a, b string) bool {
+	var res int
+
+	switch c {
+	case CollationCaseInsensitive:
+		res = strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	case CollationInitialisms:
+		res = compareWords(nameWords(a), nameWords(b))
+	case CollationNatural:
+		res = compareNatural(a, b)
+	}
+
+	if res == 0 {
+		return a < b
+	}
+
+	return res < 0
+}
+
+// String returns the name of the collation.
+func (c Collation) String() string {
+	switch c {
+	case CollationBytewise:
+		return "bytewise"
+	case CollationCaseInsensitive:
+		return "case-insensitive"
+	case CollationInitialisms:
+		return "initialisms"
+	case CollationNatural:
+		return "natural"
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,217 @@
+package nit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestCollation_Less(t *testing.T) {
+	tests := [...]struct {
+		name      string
+		collation nit.Collation
+		a         string
+		b         string
+		expected  bool
+	}{
+		{
+			"bytewise: uppercase first",
+			nit.CollationBytewise,
+			"HTTPServer",
+			"Handler",
+			true,
+		},
+		{
+			"bytewise: digits",
+			nit.CollationBytewise,
+			"item10",
+			"item2",
+			true,
+		},
+		{
+			"case-insensitive",
+			nit.CollationCaseInsensitive,
+			"Handler",
+			"HTTPServer",
+			true,
+		},
+		{
+			"case-insensitive: tie",
+			nit.CollationCaseInsensitive,
+			"ID",
+			"Id",
+			true,
+		},
+		{
+			"natural",
+			nit.CollationNatural,
+			"item2",
+			"item10",
+			true,
+		},
+		{
+			"natural: leading zeros",
+			nit.CollationNatural,
+			"item002",
+			"item10",
+			true,
+		},
+		{
+			"natural: prefix",
+			nit.CollationNatural,
+			"item",
+			"item1",
+			true,
+		},
+		{
+			"initialisms",
+			nit.CollationInitialisms,
+			"Handler",
+			"HTTPServer",
+			true,
+		},
+		{
+			"initialisms: words",
+			nit.CollationInitialisms,
+			"IDList",
+			"Identity",
+			true,
+		},
+		{
+			"initialisms: digits",
+			nit.CollationInitialisms,
+			"HTTP2Server",
+			"HTTP10Server",
+			true,
+		},
+		{
+			"initialisms: not less",
+			nit.CollationInitialisms,
+			"ServeHTTP",
+			"Serve",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			if actual := tt.collation.Less(tt.a, tt.b); actual != tt.expected {
+				ts.Fatalf("expected %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewCollation(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		input         string
+		expected      nit.Collation
+		expectedError bool
+	}{
+		{
+			"OK: bytewise",
+			"bytewise",
+			nit.CollationBytewise,
+			false,
+		},
+		{
+			"OK: case-insensitive",
+			"case-insensitive",
+			nit.CollationCaseInsensitive,
+			false,
+		},
+		{
+			"OK: initialisms",
+			"initialisms",
+			nit.CollationInitialisms,
+			false,
+		},
+		{
+			"OK: natural",
+			"natural",
+			nit.CollationNatural,
+			false,
+		},
+		{
+			"Error",
+			"alphabetical",
+			nit.CollationBytewise,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, err := nit.NewCollation(tt.input)
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+
+			if actual != tt.expected {
+				ts.Fatalf("expected %s, got %s", tt.expected, actual)
+			}
+
+			if !tt.expectedError && actual.String() != tt.input {
+				ts.Fatalf("expected %s, got %s", tt.input, actual)
+			}
+		})
+	}
+}
+
+func TestNitpicker_ValidateCollation(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		collation     nit.Collation
+		expectedError bool
+	}{
+		{
+			"OK",
+			"collation_names.go",
+			nit.CollationInitialisms,
+			false,
+		},
+		{
+			"OK: case-insensitive exported and unexported",
+			"collation_mixed.go",
+			nit.CollationCaseInsensitive,
+			false,
+		},
+		{
+			"OK: bytewise exported and unexported",
+			"collation_mixed.go",
+			nit.CollationBytewise,
+			false,
+		},
+		{
+			"Error: case-insensitive",
+			"collation_names.go",
+			nit.CollationCaseInsensitive,
+			true,
+		},
+		{
+			"Error: bytewise",
+			"collation_names.go",
+			nit.CollationBytewise,
+			true,
+		},
+		{
+			"Error: natural",
+			"collation_names.go",
+			nit.CollationNatural,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			n := nit.Nitpicker{Collation: tt.collation}
+
+			if err := n.Validate(filepath.Join("testdata", tt.filename)); tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// ConstructorsValidator defines the type including the rules used for
+	// validating constructors, those are functions named `New<Type>` or
+	// returning `<Type>` or `*<Type>`, where `<Type>` is declared in the file.
+	ConstructorsValidator struct {
+		sortedNamesValidator
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		//-
+		types     map[string]int
+		lastIndex int
+		funcs     bool
+	}
+)
+
+// NewConstructorsValidator returns a correctly initialized
+// ConstructorsValidator, when no types are found no constructors are
+// detected.
+func NewConstructorsValidator(t TypesFound) *ConstructorsValidator {
+	ts := make(map[string]int)
+
+	if t != nil && !reflect.ValueOf(t).IsNil() {
+		for i, tf := range t.Types() {
+			ts[tf] = i
+		}
+	}
+
+	return &ConstructorsValidator{
+		types:                ts,
+		lastIndex:            -1,
+		sortedNamesValidator: sortedNamesValidator{identType: "Constructor"},
+	}
+}
+
+// IsConstructor indicates whether the function is a constructor of one of the
+// types found.
+func (c *ConstructorsValidator) IsConstructor(v *ast.FuncDecl) bool {
+	_, ok := c.constructedType(v)
+	return ok
+}
+
+// Validate makes sure the implemented function satisfies the following rules
+// considering all previous declared functions:
+// * Constructors are declared before any other function, and
+// * Constructors are sorted following the order of the types they construct.
+func (c *ConstructorsValidator) Validate(v *ast.FuncDecl, fset *token.FileSet) error {
+	c.collation = c.Collation
+
+	index, ok := c.constructedType(v)
+	if !ok {
+		c.funcs = true
+		return nil
+	}
+
+	errPrefix := fset.PositionFor(v.Pos(), false).String()
+
+	if c.funcs {
+		return errors.Wrap(errors.Errorf("%s `%s` must be declared before other functions", c.identType, v.Name.Name), errPrefix)
+	}
+
+	if index < c.lastIndex {
+		return errors.Wrap(errors.Errorf("%s `%s` is not sorted by type", c.identType, v.Name.Name), errPrefix)
+	}
+
+	if index != c.lastIndex {
+		c.last = ""
+		c.lastIndex = index
+	}
+
+	return c.validateSortedName(errPrefix, v.Name)
+}
+
+//-
+
+func (c *ConstructorsValidator) constructedType(v *ast.FuncDecl) (int, bool) {
+	if v.Recv != nil {
+		return 0, false
+	}
+
+	if v.Type.Results != nil && len(v.Type.Results.List) > 0 {
+		rtype := v.Type.Results.List[0].Type
+		if star, ok := rtype.(*ast.StarExpr); ok {
+			rtype = star.X
+		}
+
+		if ident, ok := rtype.(*ast.Ident); ok {
+			if index, ok := c.types[ident.Name]; ok {
+				return index, true
+			}
+		}
+	}
+
+	if strings.HasPrefix(v.Name.Name, "New") {
+		if index, ok := c.types[strings.TrimPrefix(v.Name.Name, "New")]; ok {
+			return index, true
+		}
+	}
+
+	return 0, false
+}
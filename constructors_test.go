@@ -0,0 +1,83 @@
+package nit_test
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+//nolint:dupl
+func TestConstructorsValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		expectedError bool
+	}{
+		{
+			"OK",
+			"constructors_valid.go",
+			false,
+		},
+		{
+			"OK: no types",
+			"funcs_valid.go",
+			false,
+		},
+		{
+			"Error: first",
+			"constructors_first.go",
+			true,
+		},
+		{
+			"Error: sorted",
+			"constructors_sorted.go",
+			true,
+		},
+		{
+			"Error: sorted name",
+			"constructors_sorted_name.go",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			var (
+				err        error
+				f          *ast.File
+				fset       *token.FileSet
+				tvalidator *nit.TypesValidator
+				validator  *nit.ConstructorsValidator
+			)
+
+			f, fset = newParserFile(ts, tt.filename)
+
+			comments := nit.NewBreakComments(fset, f.Comments)
+
+			for _, s := range f.Decls {
+				switch g := s.(type) {
+				case *ast.GenDecl:
+					if g.Tok == token.TYPE {
+						tvalidator = nit.NewTypesValidator(comments)
+						if err1 := tvalidator.Validate(g, fset); err1 != nil {
+							ts.Fatalf("expected no error, got %s", err1)
+						}
+					}
+				case *ast.FuncDecl:
+					if validator == nil {
+						validator = nit.NewConstructorsValidator(tvalidator)
+					}
+
+					if err = validator.Validate(g, fset); err != nil {
+						break
+					}
+				}
+			}
+			if tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
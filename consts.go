@@ -12,21 +12,59 @@ type (
 	// the `const` sections.
 	ConstsValidator struct {
 		sortedNamesValidator
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		// DeclarationOrder indicates names must not be used for initializing
+		// names declared before them in the same block.
+		DeclarationOrder bool
+		// DependencyOrder indicates names initialized using names declared
+		// before them in the same block are not required to be sorted.
+		DependencyOrder bool
+		// ParenthesesThreshold is the `const` value of ParenthesesThresholds.
+		ParenthesesThreshold int
+		// Visibility defines how exported and unexported names are grouped.
+		Visibility VisibilityPolicy
 	}
 )
 
+func hasIota(e ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		if i, ok := n.(*ast.Ident); ok && i.Name == "iota" {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+//-
+
 // Validate makes sure the implemented `const` declaration satisfies the
 // following rules:
-// * Group declaration is parenthesized
-// * Declarations are sorted
+// * Group declaration is parenthesized, unless ParenthesesThreshold allows it
+// * Declarations are sorted, except the ones using `iota` and the following
+// ones implicitly repeating it, and
+// * Declarations follow DependencyOrder and DeclarationOrder when set.
 func (c *ConstsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error { //nolint: gocyclo
 	c.identType = "Const"
+	c.collation = c.Collation
+	c.visibility = c.Visibility
+	c.declarations = c.DeclarationOrder
+	c.dependencies = c.DependencyOrder
 
-	if !v.Lparen.IsValid() {
-		return errors.Wrap(errors.New("expected parenthesized declaration"), fset.PositionFor(v.Pos(), false).String())
+	if err := validateParentheses(v, c.ParenthesesThreshold, fset); err != nil {
+		return err
 	}
 
-	for _, t := range v.Specs {
+	var enum bool
+
+	declared := declaredNames(v)
+
+	for i, t := range v.Specs {
 		errPrefix := fset.PositionFor(t.Pos(), false).String()
 
 		s, ok := t.(*ast.ValueSpec)
@@ -34,22 +72,16 @@ func (c *ConstsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error {
 			return errors.Wrap(errors.Errorf("invalid token %+v", t), errPrefix)
 		}
 
-		for _, vss := range s.Values {
-			bexpr, ok := vss.(*ast.BinaryExpr)
-			if ok {
-				vss = bexpr.Y
-			}
+		if len(s.Values) > 0 {
+			enum = hasIota(s.Values[0])
+		}
 
-			_, ok = vss.(*ast.Ident)
-			if ok {
-				return nil // iota
-			}
+		if enum {
+			continue
 		}
 
-		for _, name := range s.Names {
-			if err := c.validateName(errPrefix, name); err != nil {
-				return err
-			}
+		if err := c.validateValueSpec(s, i, declared, fset); err != nil {
+			return err
 		}
 	}
 
@@ -45,6 +45,11 @@ func TestConstsValidator_Validate(t *testing.T) {
 			"consts_sorted.go",
 			true,
 		},
+		{
+			"Error: iota mixed",
+			"consts_iota_mixed.go",
+			true,
+		},
 	}
 
 	for _, tt := range tests {
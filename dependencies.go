@@ -0,0 +1,55 @@
+package nit
+
+import (
+	"go/ast"
+)
+
+// declaredNames returns the index of the spec declaring each one of the names
+// in the `const` or `var` declaration, indexed by their resolved object.
+func declaredNames(v *ast.GenDecl) map[*ast.Object]int {
+	res := make(map[*ast.Object]int)
+
+	for i, t := range v.Specs {
+		s, ok := t.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+
+		for _, name := range s.Names {
+			if name.Name != "_" && name.Obj != nil {
+				res[name.Obj] = i
+			}
+		}
+	}
+
+	return res
+}
+
+// usedNames returns the identifiers, included in the expressions, resolved to
+// declared names; selectors and composite literals keys are ignored.
+func usedNames(exprs []ast.Expr, declared map[*ast.Object]int) []*ast.Ident {
+	var res []*ast.Ident
+
+	for _, e := range exprs {
+		ast.Inspect(e, func(n ast.Node) bool {
+			switch t := n.(type) {
+			case *ast.Ident:
+				if _, ok := declared[t.Obj]; ok {
+					res = append(res, t)
+				}
+			case *ast.KeyValueExpr:
+				if _, ok := t.Key.(*ast.Ident); ok {
+					res = append(res, usedNames([]ast.Expr{t.Value}, declared)...)
+					return false
+				}
+			case *ast.SelectorExpr:
+				res = append(res, usedNames([]ast.Expr{t.X}, declared)...)
+				return false
+			}
+
+			return true
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,92 @@
+package nit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestNitpicker_ValidateDependencies(t *testing.T) {
+	tests := [...]struct {
+		name             string
+		filename         string
+		dependencyOrder  bool
+		declarationOrder bool
+		expectedError    bool
+	}{
+		{
+			"OK: vars dependency order",
+			"dependencies_vars.go",
+			true,
+			false,
+			false,
+		},
+		{
+			"OK: consts dependency order",
+			"dependencies_consts.go",
+			true,
+			false,
+			false,
+		},
+		{
+			"OK: declaration order disabled",
+			"dependencies_declaration.go",
+			false,
+			false,
+			false,
+		},
+		{
+			"OK: declaration order field names",
+			"dependencies_selector.go",
+			false,
+			true,
+			false,
+		},
+		{
+			"OK: declaration order shadowed names",
+			"dependencies_shadowed.go",
+			false,
+			true,
+			false,
+		},
+		{
+			"Error: vars sorted",
+			"dependencies_vars.go",
+			false,
+			false,
+			true,
+		},
+		{
+			"Error: consts sorted",
+			"dependencies_consts.go",
+			false,
+			false,
+			true,
+		},
+		{
+			"Error: declaration order",
+			"dependencies_declaration.go",
+			false,
+			true,
+			true,
+		},
+		{
+			"Error: declaration order func literal",
+			"dependencies_func_literal.go",
+			false,
+			true,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			n := nit.Nitpicker{DependencyOrder: tt.dependencyOrder, DeclarationOrder: tt.declarationOrder}
+
+			if err := n.Validate(filepath.Join("testdata", tt.filename)); tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// DirectivesValidator defines the type including the rules used for
+	// validating the file-level directives and build constraints.
+	DirectivesValidator struct {
+		// GenerateFile defines the only file name, in each package, allowed
+		// to include `//go:generate` directives; any file when empty.
+		GenerateFile string
+		// VarsParenthesesThreshold is the `var` value of ParenthesesThresholds,
+		// `//go:embed` directives can precede the declarations it allows.
+		VarsParenthesesThreshold int
+	}
+)
+
+const (
+	buildDirective    = "//go:build"
+	embedDirective    = "//go:embed"
+	generateDirective = "//go:generate"
+	plusBuild         = "// +build"
+)
+
+// Validate makes sure the directives in the file satisfy the following rules:
+// * `//go:build` constraints precede the package clause, followed by a blank
+// line, and only one is declared,
+// * `//go:generate` directives are declared before any declaration, in
+// GenerateFile when set, and
+// * `//go:embed` directives are declared in the parenthesized `var` section,
+// or before an unparenthesized `var` declaration VarsParenthesesThreshold
+// allows.
+func (d *DirectivesValidator) Validate(filename string, f *ast.File, fset *token.FileSet) error {
+	var builds int
+
+	for _, cg := range f.Comments {
+		for i, c := range cg.List {
+			errPrefix := fset.PositionFor(c.Pos(), false).String()
+
+			switch {
+			case strings.HasPrefix(c.Text, buildDirective):
+				builds++
+
+				if err := d.validateBuild(f, cg, i, builds); err != nil {
+					return errors.Wrap(err, errPrefix)
+				}
+			case strings.HasPrefix(c.Text, generateDirective):
+				if err := d.validateGenerate(filename, f, c); err != nil {
+					return errors.Wrap(err, errPrefix)
+				}
+			case strings.HasPrefix(c.Text, embedDirective):
+				if err := d.validateEmbed(f, c); err != nil {
+					return errors.Wrap(err, errPrefix)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+//-
+
+func (d *DirectivesValidator) validateBuild(f *ast.File, cg *ast.CommentGroup, index, builds int) error {
+	if builds > 1 {
+		return errors.New("only one `//go:build` constraint is allowed per file")
+	}
+
+	if cg.Pos() > f.Package {
+		return errors.New("`//go:build` must precede the package clause")
+	}
+
+	if cg == f.Doc {
+		return errors.New("`//go:build` must be followed by a blank line")
+	}
+
+	for _, c := range cg.List[index+1:] {
+		if !strings.HasPrefix(c.Text, plusBuild) {
+			return errors.New("`//go:build` must be followed by a blank line")
+		}
+	}
+
+	return nil
+}
+
+func (d *DirectivesValidator) validateEmbed(f *ast.File, c *ast.Comment) error {
+	for _, decl := range f.Decls {
+		g, ok := decl.(*ast.GenDecl)
+		if !ok || g.Tok != token.VAR {
+			continue
+		}
+
+		if g.Lparen.IsValid() && c.Pos() > g.Lparen && c.Pos() < g.Rparen {
+			return nil
+		}
+
+		if !g.Lparen.IsValid() && len(g.Specs) <= d.VarsParenthesesThreshold && g.Doc != nil && c.Pos() >= g.Doc.Pos() && c.End() <= g.Doc.End() {
+			return nil
+		}
+	}
+
+	if d.VarsParenthesesThreshold > 0 {
+		return errors.New("`//go:embed` must be declared in the `var` section")
+	}
+
+	return errors.New("`//go:embed` must be declared in the parenthesized `var` section")
+}
+
+func (d *DirectivesValidator) validateGenerate(filename string, f *ast.File, c *ast.Comment) error {
+	if d.GenerateFile != "" && filepath.Base(filename) != d.GenerateFile {
+		return errors.Errorf("`//go:generate` is only allowed in `%s`", d.GenerateFile)
+	}
+
+	if len(f.Decls) > 0 && c.Pos() > f.Decls[0].Pos() {
+		return errors.New("`//go:generate` must be declared before any declaration")
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package nit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestDirectivesValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		generateFile  string
+		varsThreshold int
+		expectedError bool
+	}{
+		{
+			"OK",
+			"directives_valid.go",
+			"",
+			0,
+			false,
+		},
+		{
+			"OK: generate file",
+			"directives_valid.go",
+			"directives_valid.go",
+			0,
+			false,
+		},
+		{
+			"Error: build blank line",
+			"directives_build_blank.go",
+			"",
+			0,
+			true,
+		},
+		{
+			"Error: build package doc",
+			"directives_build_doc.go",
+			"",
+			0,
+			true,
+		},
+		{
+			"Error: build package clause",
+			"directives_build_package.go",
+			"",
+			0,
+			true,
+		},
+		{
+			"Error: build twice",
+			"directives_build_twice.go",
+			"",
+			0,
+			true,
+		},
+		{
+			"Error: generate",
+			"directives_generate.go",
+			"",
+			0,
+			true,
+		},
+		{
+			"Error: generate file",
+			"directives_valid.go",
+			"generate.go",
+			0,
+			true,
+		},
+		{
+			"OK: embed unparenthesized",
+			"directives_embed.go",
+			"",
+			1,
+			false,
+		},
+		{
+			"Error: embed",
+			"directives_embed.go",
+			"",
+			0,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			f, fset := newParserFile(ts, tt.filename)
+
+			validator := nit.DirectivesValidator{GenerateFile: tt.generateFile, VarsParenthesesThreshold: tt.varsThreshold}
+			if err := validator.Validate(filepath.Join("testdata", tt.filename), f, fset); tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
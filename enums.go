@@ -0,0 +1,82 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// EnumsValidator defines the type including the rules used for validating
+	// the typed enums, those are `const` blocks using `iota` with a type
+	// declared in the file.
+	EnumsValidator struct {
+		types     map[string]int
+		lastIndex int
+		consts    bool
+	}
+)
+
+// NewEnumsValidator returns a correctly initialized EnumsValidator, when no
+// types are found no enums are detected.
+func NewEnumsValidator(t TypesFound) *EnumsValidator {
+	ts := make(map[string]int)
+
+	if t != nil && !reflect.ValueOf(t).IsNil() {
+		for i, tf := range t.Types() {
+			ts[tf] = i
+		}
+	}
+
+	return &EnumsValidator{types: ts, lastIndex: -1}
+}
+
+// Validate makes sure the implemented `const` declaration satisfies the
+// following rules considering all previous declared `const` blocks:
+// * Typed enums are declared before other `const` blocks, and
+// * Typed enums follow the order of their types declaration.
+func (e *EnumsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error {
+	name, index, ok := e.enumType(v)
+	if !ok {
+		e.consts = true
+		return nil
+	}
+
+	errPrefix := fset.PositionFor(v.Pos(), false).String()
+
+	if e.consts {
+		return errors.Wrap(errors.Errorf("Enum `%s` must be declared before other `const` blocks", name), errPrefix)
+	}
+
+	if index <= e.lastIndex {
+		return errors.Wrap(errors.Errorf("Enum `%s` must follow the types declaration order", name), errPrefix)
+	}
+
+	e.lastIndex = index
+
+	return nil
+}
+
+//-
+
+func (e *EnumsValidator) enumType(v *ast.GenDecl) (string, int, bool) {
+	for _, t := range v.Specs {
+		s, ok := t.(*ast.ValueSpec)
+		if !ok || len(s.Values) == 0 || !hasIota(s.Values[0]) {
+			continue
+		}
+
+		ident, ok := s.Type.(*ast.Ident)
+		if !ok {
+			return "", 0, false
+		}
+
+		index, ok := e.types[ident.Name]
+
+		return ident.Name, index, ok
+	}
+
+	return "", 0, false
+}
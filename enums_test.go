@@ -0,0 +1,78 @@
+package nit_test
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+//nolint:dupl
+func TestEnumsValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		expectedError bool
+	}{
+		{
+			"OK",
+			"enums_valid.go",
+			false,
+		},
+		{
+			"OK: no types",
+			"consts_iota.go",
+			false,
+		},
+		{
+			"Error: first",
+			"enums_first.go",
+			true,
+		},
+		{
+			"Error: sorted",
+			"enums_sorted.go",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			var (
+				err        error
+				tvalidator *nit.TypesValidator
+				validator  *nit.EnumsValidator
+			)
+
+			f, fset := newParserFile(ts, tt.filename)
+
+			comments := nit.NewBreakComments(fset, f.Comments)
+
+			for _, s := range f.Decls {
+				g, ok := s.(*ast.GenDecl)
+				if !ok || err != nil {
+					continue
+				}
+
+				switch g.Tok {
+				case token.TYPE:
+					tvalidator = nit.NewTypesValidator(comments)
+					if err1 := tvalidator.Validate(g, fset); err1 != nil {
+						ts.Fatalf("expected no error, got %s", err1)
+					}
+				case token.CONST:
+					if validator == nil {
+						validator = nit.NewEnumsValidator(tvalidator)
+					}
+
+					err = validator.Validate(g, fset)
+				}
+			}
+
+			if tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
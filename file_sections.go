@@ -117,6 +117,43 @@ func NewGenDeclFileSection(decl *ast.GenDecl) (FileSection, error) {
 	return FileSectionImports, fmt.Errorf("unknown generic declaration node")
 }
 
+// newDeclFileSection returns the section matching the name used for
+// configuring declarations: imports, types, consts or vars.
+func newDeclFileSection(name string) (FileSection, bool) {
+	sections := map[string]FileSection{
+		"consts":  FileSectionConsts,
+		"imports": FileSectionImports,
+		"types":   FileSectionTypes,
+		"vars":    FileSectionVars,
+	}
+
+	section, ok := sections[name]
+
+	return section, ok
+}
+
+// String returns the name of the section.
+func (s FileSection) String() string {
+	switch s {
+	case FileSectionConsts:
+		return "consts"
+	case FileSectionFuncs:
+		return "funcs"
+	case FileSectionImports:
+		return "imports"
+	case FileSectionMethods:
+		return "methods"
+	case FileSectionTypes:
+		return "types"
+	case FileSectionVars:
+		return "vars"
+	}
+
+	return "unknown"
+}
+
+//-
+
 // Transition updates the internal state.
 func (v *FileSectionMachine) Transition(next FileSection) error { //nolint:gocyclo
 	var (
@@ -303,5 +340,5 @@ func (varsFileSection) Types() (FileSectionTransition, error) {
 }
 
 func (varsFileSection) Vars() (FileSectionTransition, error) {
-	return nil, errors.New("`var` is invalid, next one must be `functions` or `methods`")
+	return varsFileSection{}, nil
 }
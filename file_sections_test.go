@@ -333,7 +333,7 @@ func TestVarsFileSectionTransition(t *testing.T) {
 		{
 			"Vars",
 			i.Vars,
-			true,
+			false,
 		},
 	}
 
@@ -10,7 +10,11 @@ type (
 	// functions.
 	FuncsValidator struct {
 		sortedNamesValidator
-
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		// Visibility defines how exported and unexported names are grouped.
+		Visibility VisibilityPolicy
+		//-
 		comments *BreakComments
 	}
 )
@@ -26,6 +30,9 @@ func NewFuncsValidator(c *BreakComments) *FuncsValidator {
 // * Sorted unexported functions are declared next, and
 // * Both groups can declare their own sorted subgroups,
 func (f *FuncsValidator) Validate(v *ast.FuncDecl, fset *token.FileSet) error {
+	f.collation = f.Collation
+	f.visibility = f.Visibility
+
 	errPrefix := fset.PositionFor(v.Pos(), false).String()
 
 	if err := f.validateExported(errPrefix, v.Name); err != nil {
@@ -13,31 +13,49 @@ func TestFuncsValidator_Validate(t *testing.T) {
 	tests := [...]struct {
 		name          string
 		filename      string
+		visibility    nit.VisibilityPolicy
 		expectedError bool
 	}{
 		{
 			"OK",
 			"funcs_valid.go",
+			nit.VisibilityPolicyExportedFirst,
 			false,
 		},
 		{
 			"OK: grouped",
 			"funcs_group.go",
+			nit.VisibilityPolicyExportedFirst,
 			false,
 		},
 		{
 			"OK: sorted",
 			"funcs_sorted_ok.go",
+			nit.VisibilityPolicyExportedFirst,
+			false,
+		},
+		{
+			"OK: unexported first",
+			"visibility_unexported.go",
+			nit.VisibilityPolicyUnexportedFirst,
 			false,
 		},
 		{
 			"Error: sorted",
 			"funcs_sorted.go",
+			nit.VisibilityPolicyExportedFirst,
 			true,
 		},
 		{
 			"Error: grouped",
 			"funcs_group_error.go",
+			nit.VisibilityPolicyExportedFirst,
+			true,
+		},
+		{
+			"Error: unexported first",
+			"visibility_unexported.go",
+			nit.VisibilityPolicyExportedFirst,
 			true,
 		},
 	}
@@ -57,6 +75,7 @@ func TestFuncsValidator_Validate(t *testing.T) {
 
 			comments := nit.NewBreakComments(fset, f.Comments)
 			validator := nit.NewFuncsValidator(comments)
+			validator.Visibility = tt.visibility
 
 			for _, s := range f.Decls {
 				switch g := s.(type) {
@@ -0,0 +1,164 @@
+package nit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Graph represents the states and the valid transitions of a state
+	// machine, used for generating diagrams.
+	Graph struct {
+		Name   string
+		States []string
+		Edges  []GraphEdge
+	}
+
+	// GraphEdge represents a valid transition between two Graph states, From
+	// and To are indexes of States.
+	GraphEdge struct {
+		From int
+		To   int
+	}
+
+	// GraphFormat represents the format used for rendering a Graph.
+	GraphFormat uint8
+)
+
+const (
+	// GraphFormatDot represents the Graphviz DOT format.
+	GraphFormatDot GraphFormat = iota
+
+	// GraphFormatMermaid represents the Mermaid state diagram format.
+	GraphFormatMermaid
+)
+
+// NewFileSectionsGraph returns the Graph of the file sections, the transitions
+// are determined by probing each section with FileSectionMachine; sections
+// allowing one block only, following policies or DefaultBlocksPolicies, do
+// not transition to themselves.
+func NewFileSectionsGraph(policies map[FileSection]BlocksPolicy) (*Graph, error) {
+	sections := []FileSection{
+		FileSectionImports,
+		FileSectionTypes,
+		FileSectionConsts,
+		FileSectionVars,
+		FileSectionFuncs,
+		FileSectionMethods,
+	}
+
+	res := Graph{Name: "sections"}
+
+	for _, s := range sections {
+		res.States = append(res.States, s.String())
+	}
+
+	defaults := DefaultBlocksPolicies()
+
+	for i, from := range sections {
+		for j, to := range sections {
+			if i == j {
+				policy, ok := policies[from]
+				if !ok {
+					policy, ok = defaults[from]
+				}
+
+				if ok && policy == BlocksPolicyOne {
+					continue
+				}
+			}
+
+			fsm, err := NewFileSectionMachine(from)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := fsm.Transition(to); err == nil {
+				res.Edges = append(res.Edges, GraphEdge{From: i, To: j})
+			}
+		}
+	}
+
+	return &res, nil
+}
+
+// NewGraphFormat returns the format matching the received value: dot or
+// mermaid.
+func NewGraphFormat(s string) (GraphFormat, error) {
+	switch s {
+	case "dot":
+		return GraphFormatDot, nil
+	case "mermaid":
+		return GraphFormatMermaid, nil
+	}
+
+	return GraphFormatDot, errors.Errorf("invalid graph format: %s", s)
+}
+
+// NewImportsGroupsGraph returns the Graph of the `imports` groups, the
+// transitions are determined by probing each group with ImportsSectionMachine,
+// when groups is empty DefaultImportsGroups is used.
+func NewImportsGroupsGraph(groups []ImportsGroup) (*Graph, error) {
+	if len(groups) == 0 {
+		groups = DefaultImportsGroups()
+	}
+
+	res := Graph{Name: "imports"}
+
+	for _, g := range groups {
+		res.States = append(res.States, g.String())
+	}
+
+	for i := range groups {
+		for j := range groups {
+			fsm, err := NewImportsGroupsMachine(groups, ImportsSection(i))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := fsm.Transition(ImportsSection(j)); err == nil {
+				res.Edges = append(res.Edges, GraphEdge{From: i, To: j})
+			}
+		}
+	}
+
+	return &res, nil
+}
+
+//-
+
+// Render returns the Graph using the received format.
+func (g *Graph) Render(format GraphFormat) string {
+	var b strings.Builder
+
+	switch format {
+	case GraphFormatDot:
+		fmt.Fprintf(&b, "digraph %s {\n", g.Name)
+		fmt.Fprintln(&b, "\trankdir=LR;")
+
+		for i, s := range g.States {
+			fmt.Fprintf(&b, "\ts%d [label=%s];\n", i, strconv.Quote(s))
+		}
+
+		for _, e := range g.Edges {
+			fmt.Fprintf(&b, "\ts%d -> s%d;\n", e.From, e.To)
+		}
+
+		fmt.Fprintln(&b, "}")
+	case GraphFormatMermaid:
+		fmt.Fprintln(&b, "stateDiagram-v2")
+
+		for i, s := range g.States {
+			fmt.Fprintf(&b, "\tstate %s as s%d\n", strconv.Quote(s), i)
+		}
+
+		for _, e := range g.Edges {
+			fmt.Fprintf(&b, "\ts%d --> s%d\n", e.From, e.To)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,128 @@
+package nit_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestGraph_Render(t *testing.T) {
+	groups, err := nit.NewImportsGroups("standard,prefix(github.com/org),local")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	g, err := nit.NewImportsGroupsGraph(groups)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	tests := [...]struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			"OK: dot",
+			"dot",
+			"digraph imports {\n" +
+				"\trankdir=LR;\n" +
+				"\ts0 [label=\"standard\"];\n" +
+				"\ts1 [label=\"prefix(github.com/org)\"];\n" +
+				"\ts2 [label=\"local\"];\n" +
+				"\ts0 -> s0;\n" +
+				"\ts0 -> s1;\n" +
+				"\ts0 -> s2;\n" +
+				"\ts1 -> s1;\n" +
+				"\ts1 -> s2;\n" +
+				"\ts2 -> s2;\n" +
+				"}\n",
+		},
+		{
+			"OK: mermaid",
+			"mermaid",
+			"stateDiagram-v2\n" +
+				"\tstate \"standard\" as s0\n" +
+				"\tstate \"prefix(github.com/org)\" as s1\n" +
+				"\tstate \"local\" as s2\n" +
+				"\ts0 --> s0\n" +
+				"\ts0 --> s1\n" +
+				"\ts0 --> s2\n" +
+				"\ts1 --> s1\n" +
+				"\ts1 --> s2\n" +
+				"\ts2 --> s2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			f, err := nit.NewGraphFormat(tt.format)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			if actual := g.Render(f); !cmp.Equal(tt.expected, actual) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+func TestNewFileSectionsGraph(t *testing.T) {
+	tests := [...]struct {
+		name     string
+		policies string
+		expected []nit.GraphEdge
+	}{
+		{
+			"OK: default",
+			"",
+			[]nit.GraphEdge{
+				{From: 0, To: 0}, {From: 0, To: 1}, {From: 0, To: 2}, {From: 0, To: 3}, {From: 0, To: 4}, {From: 0, To: 5},
+				{From: 1, To: 2}, {From: 1, To: 3}, {From: 1, To: 4}, {From: 1, To: 5},
+				{From: 2, To: 2}, {From: 2, To: 3}, {From: 2, To: 4}, {From: 2, To: 5},
+				{From: 3, To: 4}, {From: 3, To: 5},
+				{From: 4, To: 4}, {From: 4, To: 5},
+				{From: 5, To: 5},
+			},
+		},
+		{
+			"OK: many",
+			"imports=one,types=many,consts=one,vars=many-sorted",
+			[]nit.GraphEdge{
+				{From: 0, To: 1}, {From: 0, To: 2}, {From: 0, To: 3}, {From: 0, To: 4}, {From: 0, To: 5},
+				{From: 1, To: 1}, {From: 1, To: 2}, {From: 1, To: 3}, {From: 1, To: 4}, {From: 1, To: 5},
+				{From: 2, To: 3}, {From: 2, To: 4}, {From: 2, To: 5},
+				{From: 3, To: 3}, {From: 3, To: 4}, {From: 3, To: 5},
+				{From: 4, To: 4}, {From: 4, To: 5},
+				{From: 5, To: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			policies, err := nit.NewBlocksPolicies(tt.policies)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			g, err := nit.NewFileSectionsGraph(policies)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(tt.expected, g.Edges) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, g.Edges))
+			}
+		})
+	}
+}
+
+func TestNewGraphFormat(t *testing.T) {
+	if _, err := nit.NewGraphFormat("png"); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
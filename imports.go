@@ -9,19 +9,24 @@ import (
 )
 
 type (
-	// ImportsSection represents one of the 3 valid `imports` sections.
+	// ImportsSection represents one of the valid `imports` sections, it is the
+	// index of the corresponding group in the configured imports groups.
 	ImportsSection uint8
 
 	// ImportsSectionMachine represents the `imports` code organization state
-	// machine.
+	// machine, its transition rules are generated from the configured imports
+	// groups: the expected order is the order of the groups.
 	ImportsSectionMachine struct {
-		current  ImportsTransition
-		previous ImportsTransition
+		groups   []ImportsGroup
+		current  ImportsSection
+		previous ImportsSection
 	}
 
-	// ImportsTransition represents one of the 3 valid `imports` sections, it
+	// ImportsTransition represents one of the 3 default `imports` sections, it
 	// defines the State Machine transition rules for that concrete section,
 	// the order to be expected is: "Standard" -> "External" -> "Local".
+	//
+	// Deprecated: use ImportsSectionMachine, it supports configured groups.
 	ImportsTransition interface {
 		External() (ImportsTransition, error)
 		Local() (ImportsTransition, error)
@@ -31,15 +36,32 @@ type (
 	// ImportsValidator defines the type including the rules used for validating
 	// the `imports` section as a whole.
 	ImportsValidator struct {
+		// Groups defines the expected imports groups, in order; when empty
+		// DefaultImportsGroups is used.
+		Groups []ImportsGroup
+		// ModulePath defines the path of the current module, used by the
+		// ImportsGroupModule group.
+		ModulePath string
+		// ParenthesesThreshold is the `imports` value of ParenthesesThresholds.
+		ParenthesesThreshold int
+		// TestedPath defines the import path of the package under test, it is
+		// considered local when validating external test packages.
+		TestedPath string
+		//-
 		localPath string
 		fsm       *ImportsSectionMachine
+		paths     map[string]struct{}
 	}
 
-	externalImportsTransition struct{}
-	localImportsTransition    struct{}
-	standardImportsTransition struct{}
+	// importsTransition implements ImportsTransition using the groups of an
+	// ImportsSectionMachine, DefaultImportsGroups when nil.
+	importsTransition struct {
+		groups  *[]ImportsGroup
+		section ImportsSection
+	}
 )
 
+// The values represent the sections when using DefaultImportsGroups.
 const (
 	// ImportsSectionStd represents the Standard Library Packages `imports` section.
 	ImportsSectionStd ImportsSection = iota
@@ -51,45 +73,51 @@ const (
 	ImportsSectionLocal
 )
 
-// NewImportsSection returns the value representing the corresponding Imports
-// section.
-func NewImportsSection(path, localPathPrefix string) ImportsSection {
-	if !strings.Contains(path, ".") {
-		return ImportsSectionStd
+// NewImportsGroupsMachine returns a new ImportsSectionMachine with the
+// initial state as `start`, when groups is empty DefaultImportsGroups is used.
+func NewImportsGroupsMachine(groups []ImportsGroup, start ImportsSection) (*ImportsSectionMachine, error) {
+	if len(groups) == 0 {
+		groups = DefaultImportsGroups()
 	}
 
-	if strings.HasPrefix(strings.Replace(path, "\"", "", -1), localPathPrefix) {
-		return ImportsSectionLocal
+	if int(start) >= len(groups) {
+		return nil, errors.New("invalid imports value")
 	}
 
-	return ImportsSectionExternal
+	return &ImportsSectionMachine{groups: groups, current: start, previous: start}, nil
 }
 
-// NewImportsSectionMachine returns a new ImportsSectionMachine with the
-// initial state as `start`.
-func NewImportsSectionMachine(start ImportsSection) (*ImportsSectionMachine, error) {
-	// FIXME: implement tests
-	c, err := NewImportsTransition(start)
-	if err != nil {
-		return nil, err
+// NewImportsSection returns the value representing the corresponding Imports
+// section using DefaultImportsGroups, see IsStandardPackage for details about
+// how standard packages are determined.
+func NewImportsSection(path, localPathPrefix string) ImportsSection {
+	m := importsGroupMatch{
+		path:      strings.Replace(path, "\"", "", -1),
+		localPath: localPathPrefix,
 	}
 
-	return &ImportsSectionMachine{current: c}, nil
+	res, _ := m.section(DefaultImportsGroups())
+
+	return res
+}
+
+// NewImportsSectionMachine returns a new ImportsSectionMachine using
+// DefaultImportsGroups with the initial state as `start`, use
+// NewImportsGroupsMachine for configured groups.
+func NewImportsSectionMachine(start ImportsSection) (*ImportsSectionMachine, error) {
+	return NewImportsGroupsMachine(nil, start)
 }
 
 // NewImportsTransition returns a new transition corresponding to the received
 // value.
+//
+// Deprecated: use NewImportsSectionMachine.
 func NewImportsTransition(s ImportsSection) (ImportsTransition, error) {
-	switch s {
-	case ImportsSectionStd:
-		return standardImportsTransition{}, nil
-	case ImportsSectionExternal:
-		return externalImportsTransition{}, nil
-	case ImportsSectionLocal:
-		return localImportsTransition{}, nil
+	if int(s) >= len(DefaultImportsGroups()) {
+		return nil, errors.New("invalid imports value")
 	}
 
-	return nil, errors.New("invalid imports value")
+	return importsTransition{section: s}, nil
 }
 
 // NewImportsValidator returns a new instalce of ImporstValidator with the
@@ -98,42 +126,73 @@ func NewImportsValidator(localPath string) ImportsValidator {
 	return ImportsValidator{localPath: localPath}
 }
 
+func isCgoImport(v *ast.GenDecl) bool {
+	if v.Lparen.IsValid() || len(v.Specs) != 1 {
+		return false
+	}
+
+	s, ok := v.Specs[0].(*ast.ImportSpec)
+
+	return ok && s.Name == nil && s.Path.Value == `"C"`
+}
+
 //-
 
 // Current returns the current state.
+//
+// Deprecated: use CurrentSection.
 func (s *ImportsSectionMachine) Current() ImportsTransition {
+	return importsTransition{groups: &s.groups, section: s.current}
+}
+
+// CurrentSection returns the current state.
+func (s *ImportsSectionMachine) CurrentSection() ImportsSection {
 	return s.current
 }
 
+// Groups returns the groups used for generating the transition rules.
+func (s *ImportsSectionMachine) Groups() []ImportsGroup {
+	dst := make([]ImportsGroup, len(s.groups))
+	copy(dst, s.groups)
+
+	return dst
+}
+
 // Previous returns the previous state.
+//
+// Deprecated: use PreviousSection.
 func (s *ImportsSectionMachine) Previous() ImportsTransition {
+	return importsTransition{groups: &s.groups, section: s.previous}
+}
+
+// PreviousSection returns the previous state.
+func (s *ImportsSectionMachine) PreviousSection() ImportsSection {
 	return s.previous
 }
 
-// Transition updates the internal state.
+// Transition updates the internal state, the next section must be the current
+// one or any of the ones following it.
 func (s *ImportsSectionMachine) Transition(next ImportsSection) error {
-	var (
-		res ImportsTransition
-		err error
-	)
-
-	switch next {
-	case ImportsSectionStd:
-		res, err = s.current.Standard()
-	case ImportsSectionExternal:
-		res, err = s.current.External()
-	case ImportsSectionLocal:
-		res, err = s.current.Local()
-	default:
-		err = errors.Errorf("invalid imports value: %d", next)
+	if int(next) >= len(s.groups) {
+		return errors.Errorf("invalid imports value: %d", next)
 	}
 
-	if err != nil {
-		return err
+	if next < s.current {
+		names := make([]string, 0, len(s.groups)-int(s.current))
+		for _, g := range s.groups[s.current:] {
+			names = append(names, g.String())
+		}
+
+		expected := names[len(names)-1]
+		if len(names) > 1 {
+			expected = strings.Join(names[:len(names)-1], ", ") + " or " + expected
+		}
+
+		return errors.Errorf("%s imports is invalid, next one must be %s", s.groups[next], expected)
 	}
 
 	s.previous = s.current
-	s.current = res
+	s.current = next
 
 	return nil
 }
@@ -142,19 +201,40 @@ func (s *ImportsSectionMachine) Transition(next ImportsSection) error {
 
 // Validate makes sure the implemented `imports` declaration satisfies the
 // following rules:
-// * Group declaration is parenthesized
-// * Packages are separated by a breaking line like this:
-//   * First standard packages,
-//   * Next external packages, and
-//   * Finally local packages
+// * The standalone cgo `import "C"` declaration and its preamble are not
+// validated,
+// * Group declaration is parenthesized, unless ParenthesesThreshold allows it,
+// * Packages are separated by a breaking line following Groups, by default:
+//   - First standard packages,
+//   - Next external packages, and
+//   - Finally local packages
+//
+// * Packages in each group are sorted by path, and
+// * Packages are imported once, considering all the declarations validated.
 func (i *ImportsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error {
-	if !v.Lparen.IsValid() {
-		return errors.Wrap(errors.New("expected parenthesized declaration"), fset.PositionFor(v.Pos(), false).String())
+	if isCgoImport(v) {
+		return nil
 	}
 
-	lastLine := fset.PositionFor(v.Pos(), false).Line
+	if err := validateParentheses(v, i.ParenthesesThreshold, fset); err != nil {
+		return err
+	}
 
-	for _, t := range v.Specs {
+	var (
+		lastLine = fset.PositionFor(v.Pos(), false).Line
+		lastPath string
+	)
+
+	if i.paths == nil {
+		i.paths = make(map[string]struct{})
+	}
+
+	if !v.Lparen.IsValid() {
+		// unparenthesized specs are declared in the same line.
+		lastLine--
+	}
+
+	for index, t := range v.Specs {
 		errPrefix := fset.PositionFor(t.Pos(), false).String()
 
 		s, ok := t.(*ast.ImportSpec)
@@ -162,10 +242,30 @@ func (i *ImportsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error {
 			return errors.Wrap(errors.Errorf("invalid token %+v", t), errPrefix)
 		}
 
-		section := NewImportsSection(s.Path.Value, i.localPath)
+		m := importsGroupMatch{
+			path:       strings.Replace(s.Path.Value, "\"", "", -1),
+			localPath:  i.localPath,
+			modulePath: i.ModulePath,
+			testedPath: i.TestedPath,
+		}
+
+		if s.Name != nil {
+			m.name = s.Name.Name
+		}
+
+		if _, ok := i.paths[m.path]; ok {
+			return errors.Wrap(errors.Errorf("import `%s` is duplicated", m.path), errPrefix)
+		}
+
+		i.paths[m.path] = struct{}{}
+
+		section, ok := m.section(i.groups())
+		if !ok {
+			return errors.Wrap(errors.Errorf("import `%s` does not match any group", m.path), errPrefix)
+		}
 
 		if i.fsm == nil {
-			fsm, err := NewImportsSectionMachine(section)
+			fsm, err := NewImportsGroupsMachine(i.groups(), section)
 			if err != nil {
 				return errors.Wrap(errors.Errorf("invalid imports found: %s", err), errPrefix)
 			}
@@ -179,10 +279,14 @@ func (i *ImportsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error {
 
 		newLine := fset.PositionFor(t.Pos(), false).Line
 
-		if i.fsm.Current() == i.fsm.Previous() {
+		if i.fsm.CurrentSection() == i.fsm.PreviousSection() || index == 0 {
 			if lastLine+1 != newLine {
 				return errors.Wrap(errors.New("extra line break in section"), errPrefix)
 			}
+
+			if lastPath > m.path {
+				return errors.Wrap(errors.Errorf("import `%s` is not sorted", m.path), errPrefix)
+			}
 		} else {
 			if lastLine+1 == newLine {
 				return errors.Wrap(errors.New("missing line break in section"), errPrefix)
@@ -190,6 +294,7 @@ func (i *ImportsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error {
 		}
 
 		lastLine = newLine
+		lastPath = m.path
 	}
 
 	return nil
@@ -197,42 +302,50 @@ func (i *ImportsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error {
 
 //-
 
-func (externalImportsTransition) External() (ImportsTransition, error) {
-	return externalImportsTransition{}, nil
-}
-
-func (externalImportsTransition) Local() (ImportsTransition, error) {
-	return localImportsTransition{}, nil
-}
+func (i *ImportsValidator) groups() []ImportsGroup {
+	if len(i.Groups) == 0 {
+		return DefaultImportsGroups()
+	}
 
-func (externalImportsTransition) Standard() (ImportsTransition, error) {
-	return nil, errors.New("standard imports is invalid, next one must be external or local")
+	return i.Groups
 }
 
 //-
 
-func (localImportsTransition) External() (ImportsTransition, error) {
-	return nil, errors.New("external imports is invalid, next one must be local")
+func (t importsTransition) External() (ImportsTransition, error) {
+	return t.transition(ImportsGroupExternal)
 }
 
-func (localImportsTransition) Local() (ImportsTransition, error) {
-	return localImportsTransition{}, nil
+func (t importsTransition) Local() (ImportsTransition, error) {
+	return t.transition(ImportsGroupLocal)
 }
 
-func (localImportsTransition) Standard() (ImportsTransition, error) {
-	return nil, errors.New("standard imports is invalid, next one must be local")
+func (t importsTransition) Standard() (ImportsTransition, error) {
+	return t.transition(ImportsGroupStd)
 }
 
-//-
+func (t importsTransition) transition(kind ImportsGroupKind) (ImportsTransition, error) {
+	var groups []ImportsGroup
+	if t.groups != nil {
+		groups = *t.groups
+	}
 
-func (standardImportsTransition) External() (ImportsTransition, error) {
-	return externalImportsTransition{}, nil
-}
+	fsm, err := NewImportsGroupsMachine(groups, t.section)
+	if err != nil {
+		return nil, err
+	}
 
-func (standardImportsTransition) Local() (ImportsTransition, error) {
-	return localImportsTransition{}, nil
-}
+	for i, g := range fsm.groups {
+		if g.Kind != kind {
+			continue
+		}
+
+		if err := fsm.Transition(ImportsSection(i)); err != nil {
+			return nil, err
+		}
+
+		return importsTransition{groups: t.groups, section: ImportsSection(i)}, nil
+	}
 
-func (standardImportsTransition) Standard() (ImportsTransition, error) {
-	return standardImportsTransition{}, nil
+	return nil, errors.Errorf("%s imports is not configured", ImportsGroup{Kind: kind})
 }
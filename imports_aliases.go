@@ -0,0 +1,94 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// ImportsAliasesValidator defines the type including the rules used for
+	// validating the aliases of the imported packages.
+	ImportsAliasesValidator struct {
+		// Aliases defines the required aliases indexed by import path.
+		Aliases map[string]string
+		// AllowDotImports indicates dot imports are allowed, for example in
+		// test files.
+		AllowDotImports bool
+	}
+)
+
+const (
+	identifierExpr = `^[\pL_][\pL\pN_]*$`
+	versionExpr    = `^v[0-9]+$`
+)
+
+// Validate makes sure the implemented `imports` declaration satisfies the
+// following rules:
+// * Packages with a configured alias use it,
+// * Aliases equal to the package name are not used,
+// * Versioned paths, like `/v2`, use an alias,
+// * Paths not ending with a valid package name use an alias, and
+// * Dot imports are not used, unless AllowDotImports is set.
+func (a *ImportsAliasesValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error {
+	reident, _ := regexp.Compile(identifierExpr)
+	reversion, _ := regexp.Compile(versionExpr)
+
+	for _, t := range v.Specs {
+		errPrefix := fset.PositionFor(t.Pos(), false).String()
+
+		s, ok := t.(*ast.ImportSpec)
+		if !ok {
+			return errors.Wrap(errors.Errorf("invalid token %+v", t), errPrefix)
+		}
+
+		path := strings.Replace(s.Path.Value, "\"", "", -1)
+
+		var name string
+		if s.Name != nil {
+			name = s.Name.Name
+		}
+
+		if alias, ok := a.Aliases[path]; ok {
+			if name != alias {
+				return errors.Wrap(errors.Errorf("import `%s` must use the alias `%s`", path, alias), errPrefix)
+			}
+
+			continue
+		}
+
+		if name == "_" || path == "C" {
+			continue
+		}
+
+		if name == "." {
+			if !a.AllowDotImports {
+				return errors.Wrap(errors.Errorf("dot import `%s` is not allowed", path), errPrefix)
+			}
+
+			continue
+		}
+
+		elems := strings.Split(path, "/")
+		last := elems[len(elems)-1]
+
+		versioned := len(elems) > 1 && reversion.MatchString(last) && !IsStandardPackage(path)
+
+		if versioned || !reident.MatchString(last) {
+			if name == "" {
+				return errors.Wrap(errors.Errorf("import `%s` requires an alias", path), errPrefix)
+			}
+
+			continue
+		}
+
+		if name == last {
+			return errors.Wrap(errors.Errorf("alias `%s` for import `%s` is unnecessary", name, path), errPrefix)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package nit_test
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestImportsAliasesValidator_Validate(t *testing.T) {
+	aliases := map[string]string{"k8s.io/apimachinery/pkg/apis/meta/v1": "metav1"}
+
+	tests := [...]struct {
+		name          string
+		filename      string
+		validator     nit.ImportsAliasesValidator
+		expectedError bool
+	}{
+		{
+			"OK",
+			"imports_aliases_valid.go",
+			nit.ImportsAliasesValidator{Aliases: aliases},
+			false,
+		},
+		{
+			"OK: dot imports allowed",
+			"imports_aliases_dot.go",
+			nit.ImportsAliasesValidator{AllowDotImports: true},
+			false,
+		},
+		{
+			"Error: unnecessary",
+			"imports_aliases_unnecessary.go",
+			nit.ImportsAliasesValidator{},
+			true,
+		},
+		{
+			"Error: versioned",
+			"imports_aliases_versioned.go",
+			nit.ImportsAliasesValidator{},
+			true,
+		},
+		{
+			"Error: package name",
+			"imports_aliases_name.go",
+			nit.ImportsAliasesValidator{},
+			true,
+		},
+		{
+			"Error: dot imports",
+			"imports_aliases_dot.go",
+			nit.ImportsAliasesValidator{},
+			true,
+		},
+		{
+			"Error: configured",
+			"imports_aliases_configured.go",
+			nit.ImportsAliasesValidator{Aliases: aliases},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			f, fset := newParserFile(ts, tt.filename)
+
+			for _, s := range f.Decls {
+				if g, ok := s.(*ast.GenDecl); ok && g.Tok == token.IMPORT {
+					if err := tt.validator.Validate(g, fset); tt.expectedError != (err != nil) {
+						ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package nit
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// ImportsGroup represents one of the configured `imports` groups.
+	ImportsGroup struct {
+		Kind ImportsGroupKind
+		// Prefix defines the path prefix matched by ImportsGroupPrefix groups.
+		Prefix string
+	}
+
+	// ImportsGroupKind represents the kind of packages included in an `imports`
+	// group.
+	ImportsGroupKind uint8
+
+	// importsGroupMatch defines the values used for determining the group of
+	// an import.
+	importsGroupMatch struct {
+		name       string
+		path       string
+		localPath  string
+		modulePath string
+		testedPath string
+	}
+)
+
+// The kinds are sorted by precedence, when an import matches multiple
+// configured groups the one with the lowest value is used.
+const (
+	// ImportsGroupCgo represents the `import "C"` group.
+	ImportsGroupCgo ImportsGroupKind = iota
+
+	// ImportsGroupBlank represents the blank (`_`) imports group.
+	ImportsGroupBlank
+
+	// ImportsGroupDot represents the dot (`.`) imports group.
+	ImportsGroupDot
+
+	// ImportsGroupStd represents the Standard Library Packages group.
+	ImportsGroupStd
+
+	// ImportsGroupPrefix represents the packages matching a prefix, the
+	// longest prefix wins.
+	ImportsGroupPrefix
+
+	// ImportsGroupModule represents the current module packages group.
+	ImportsGroupModule
+
+	// ImportsGroupLocal represents the local packages group.
+	ImportsGroupLocal
+
+	// ImportsGroupExternal represents the External Packages group, it includes
+	// the imports not matching any other group.
+	ImportsGroupExternal
+)
+
+// DefaultImportsGroups returns the default `imports` groups: standard,
+// external and local.
+func DefaultImportsGroups() []ImportsGroup {
+	return []ImportsGroup{
+		{Kind: ImportsGroupStd},
+		{Kind: ImportsGroupExternal},
+		{Kind: ImportsGroupLocal},
+	}
+}
+
+// NewImportsGroups returns the groups defined in the received comma separated
+// value, valid groups are: standard, external, local, module, blank, dot, cgo
+// and prefix(<path>).
+func NewImportsGroups(s string) ([]ImportsGroup, error) {
+	var res []ImportsGroup //nolint: prealloc
+
+	found := make(map[ImportsGroup]struct{})
+
+	for _, name := range strings.Split(s, ",") {
+		g, err := newImportsGroup(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := found[g]; ok {
+			return nil, errors.Errorf("duplicated imports group: %s", g)
+		}
+
+		found[g] = struct{}{}
+
+		res = append(res, g)
+	}
+
+	return res, nil
+}
+
+func newImportsGroup(name string) (ImportsGroup, error) {
+	kinds := map[string]ImportsGroupKind{
+		"blank":    ImportsGroupBlank,
+		"cgo":      ImportsGroupCgo,
+		"dot":      ImportsGroupDot,
+		"external": ImportsGroupExternal,
+		"local":    ImportsGroupLocal,
+		"module":   ImportsGroupModule,
+		"standard": ImportsGroupStd,
+	}
+
+	if kind, ok := kinds[name]; ok {
+		return ImportsGroup{Kind: kind}, nil
+	}
+
+	if strings.HasPrefix(name, "prefix(") && strings.HasSuffix(name, ")") {
+		if prefix := strings.TrimSuffix(strings.TrimPrefix(name, "prefix("), ")"); prefix != "" {
+			return ImportsGroup{Kind: ImportsGroupPrefix, Prefix: prefix}, nil
+		}
+	}
+
+	return ImportsGroup{}, errors.Errorf("invalid imports group: %s", name)
+}
+
+//-
+
+// String returns the name of the group.
+func (g ImportsGroup) String() string {
+	switch g.Kind {
+	case ImportsGroupBlank:
+		return "blank"
+	case ImportsGroupCgo:
+		return "cgo"
+	case ImportsGroupDot:
+		return "dot"
+	case ImportsGroupExternal:
+		return "external"
+	case ImportsGroupLocal:
+		return "local"
+	case ImportsGroupModule:
+		return "module"
+	case ImportsGroupPrefix:
+		return "prefix(" + g.Prefix + ")"
+	case ImportsGroupStd:
+		return "standard"
+	}
+
+	return "unknown"
+}
+
+func (g ImportsGroup) matches(m importsGroupMatch) bool {
+	switch g.Kind {
+	case ImportsGroupBlank:
+		return m.name == "_"
+	case ImportsGroupCgo:
+		return m.path == "C"
+	case ImportsGroupDot:
+		return m.name == "."
+	case ImportsGroupExternal:
+		return true
+	case ImportsGroupLocal:
+		return strings.HasPrefix(m.path, m.localPath) || (m.testedPath != "" && m.path == m.testedPath)
+	case ImportsGroupModule:
+		return m.modulePath != "" && (m.path == m.modulePath || strings.HasPrefix(m.path, m.modulePath+"/"))
+	case ImportsGroupPrefix:
+		return strings.HasPrefix(m.path, g.Prefix)
+	case ImportsGroupStd:
+		return IsStandardPackage(m.path)
+	}
+
+	return false
+}
+
+//-
+
+// section returns the index of the group matching the import, considering the
+// kinds precedence and the longest prefix.
+func (m importsGroupMatch) section(groups []ImportsGroup) (ImportsSection, bool) {
+	var (
+		found bool
+		res   int
+	)
+
+	for i, g := range groups {
+		if !g.matches(m) {
+			continue
+		}
+
+		if !found || g.Kind < groups[res].Kind || (g.Kind == groups[res].Kind && len(g.Prefix) > len(groups[res].Prefix)) {
+			found = true
+			res = i
+		}
+	}
+
+	return ImportsSection(res), found
+}
@@ -5,6 +5,8 @@ import (
 	"go/token"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/MarioCarrion/nit"
 )
 
@@ -33,6 +35,18 @@ func TestNewImportsSection(t *testing.T) {
 			"github.com/MarioCarrion/nit",
 			nit.ImportsSectionLocal,
 		},
+		{
+			"ImportsSectionLocal: no dots",
+			"mycorp/lib",
+			"mycorp",
+			nit.ImportsSectionLocal,
+		},
+		{
+			"ImportsSectionExternal: no dots",
+			"mycorp/lib",
+			"github.com/MarioCarrion/nit",
+			nit.ImportsSectionExternal,
+		},
 	}
 
 	for _, tt := range tests {
@@ -58,6 +72,11 @@ func TestImportsValidator(t *testing.T) {
 			"imports_valid.go",
 			false,
 		},
+		{
+			"OK: cgo",
+			"imports_cgo.go",
+			false,
+		},
 		{
 			"Error: parenthesized declaration",
 			"imports_paren.go",
@@ -78,6 +97,16 @@ func TestImportsValidator(t *testing.T) {
 			"imports_invalid_group.go",
 			true,
 		},
+		{
+			"Error: sorted",
+			"imports_sorted.go",
+			true,
+		},
+		{
+			"Error: duplicated",
+			"imports_duplicated.go",
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -100,6 +129,301 @@ func TestImportsValidator(t *testing.T) {
 	}
 }
 
+func TestImportsValidator_Groups(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		groups        string
+		expectedError bool
+	}{
+		{
+			"OK",
+			"imports_groups_valid.go",
+			"standard,external,prefix(github.com/MarioCarrion/),blank,dot",
+			false,
+		},
+		{
+			"Error: order",
+			"imports_groups_order.go",
+			"standard,external,prefix(github.com/MarioCarrion/),blank,dot",
+			true,
+		},
+		{
+			"Error: unmatched",
+			"imports_groups_unmatched.go",
+			"standard,local",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			groups, err := nit.NewImportsGroups(tt.groups)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			f, fset := newParserFile(ts, tt.filename)
+
+			for _, s := range f.Decls {
+				if g, ok := s.(*ast.GenDecl); ok && g.Tok == token.IMPORT {
+					validator := nit.NewImportsValidator("github.com/MarioCarrion/nit/cmd")
+					validator.Groups = groups
+
+					if err := validator.Validate(g, fset); tt.expectedError != (err != nil) {
+						ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+//-
+
+func TestNewImportsGroups(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		input         string
+		expected      []nit.ImportsGroup
+		expectedError bool
+	}{
+		{
+			"OK",
+			"standard, prefix(github.com/mycorp/),module,blank,dot,cgo,external,local",
+			[]nit.ImportsGroup{
+				{Kind: nit.ImportsGroupStd},
+				{Kind: nit.ImportsGroupPrefix, Prefix: "github.com/mycorp/"},
+				{Kind: nit.ImportsGroupModule},
+				{Kind: nit.ImportsGroupBlank},
+				{Kind: nit.ImportsGroupDot},
+				{Kind: nit.ImportsGroupCgo},
+				{Kind: nit.ImportsGroupExternal},
+				{Kind: nit.ImportsGroupLocal},
+			},
+			false,
+		},
+		{
+			"Error: invalid",
+			"standard,unknown",
+			nil,
+			true,
+		},
+		{
+			"Error: empty prefix",
+			"prefix()",
+			nil,
+			true,
+		},
+		{
+			"Error: duplicated",
+			"standard,external,standard",
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, err := nit.NewImportsGroups(tt.input)
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+
+			if !cmp.Equal(tt.expected, actual) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+func TestNewImportsSectionMachine(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		input         nit.ImportsSection
+		expectedError bool
+	}{
+		{
+			"ImportsSectionStd",
+			nit.ImportsSectionStd,
+			false,
+		},
+		{
+			"ImportsSectionExternal",
+			nit.ImportsSectionExternal,
+			false,
+		},
+		{
+			"ImportsSectionLocal",
+			nit.ImportsSectionLocal,
+			false,
+		},
+		{
+			"Error",
+			nit.ImportsSection(3),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			_, err := nit.NewImportsSectionMachine(tt.input)
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+//-
+
+func TestImportsSectionMachine_Current(t *testing.T) {
+	fsm, err := nit.NewImportsSectionMachine(nit.ImportsSectionStd)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := fsm.Transition(nit.ImportsSectionStd); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if fsm.Current() != fsm.Previous() || fsm.CurrentSection() != fsm.PreviousSection() {
+		t.Fatalf("expected same states")
+	}
+
+	if err := fsm.Transition(nit.ImportsSectionLocal); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if fsm.Current() == fsm.Previous() || fsm.CurrentSection() != nit.ImportsSectionLocal {
+		t.Fatalf("expected different states")
+	}
+
+	if _, err := fsm.Current().External(); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
+
+func TestImportsSectionMachine_Transition(t *testing.T) {
+	custom := []nit.ImportsGroup{
+		{Kind: nit.ImportsGroupStd},
+		{Kind: nit.ImportsGroupExternal},
+		{Kind: nit.ImportsGroupPrefix, Prefix: "github.com/mycorp/"},
+		{Kind: nit.ImportsGroupBlank},
+	}
+
+	tests := [...]struct {
+		name          string
+		groups        []nit.ImportsGroup
+		start         nit.ImportsSection
+		next          nit.ImportsSection
+		expectedError string
+	}{
+		{
+			"External: External",
+			nil,
+			nit.ImportsSectionExternal,
+			nit.ImportsSectionExternal,
+			"",
+		},
+		{
+			"External: Local",
+			nil,
+			nit.ImportsSectionExternal,
+			nit.ImportsSectionLocal,
+			"",
+		},
+		{
+			"External: Standard",
+			nil,
+			nit.ImportsSectionExternal,
+			nit.ImportsSectionStd,
+			"standard imports is invalid, next one must be external or local",
+		},
+		{
+			"Local: External",
+			nil,
+			nit.ImportsSectionLocal,
+			nit.ImportsSectionExternal,
+			"external imports is invalid, next one must be local",
+		},
+		{
+			"Local: Local",
+			nil,
+			nit.ImportsSectionLocal,
+			nit.ImportsSectionLocal,
+			"",
+		},
+		{
+			"Local: Standard",
+			nil,
+			nit.ImportsSectionLocal,
+			nit.ImportsSectionStd,
+			"standard imports is invalid, next one must be local",
+		},
+		{
+			"Standard: External",
+			nil,
+			nit.ImportsSectionStd,
+			nit.ImportsSectionExternal,
+			"",
+		},
+		{
+			"Standard: Local",
+			nil,
+			nit.ImportsSectionStd,
+			nit.ImportsSectionLocal,
+			"",
+		},
+		{
+			"Standard: Standard",
+			nil,
+			nit.ImportsSectionStd,
+			nit.ImportsSectionStd,
+			"",
+		},
+		{
+			"Standard: invalid",
+			nil,
+			nit.ImportsSectionStd,
+			nit.ImportsSection(3),
+			"invalid imports value: 3",
+		},
+		{
+			"Custom: Prefix",
+			custom,
+			nit.ImportsSection(1),
+			nit.ImportsSection(2),
+			"",
+		},
+		{
+			"Custom: Standard",
+			custom,
+			nit.ImportsSection(1),
+			nit.ImportsSection(0),
+			"standard imports is invalid, next one must be external, prefix(github.com/mycorp/) or blank",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			fsm, err := nit.NewImportsGroupsMachine(tt.groups, tt.start)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			var actual string
+			if err := fsm.Transition(tt.next); err != nil {
+				actual = err.Error()
+			}
+
+			if actual != tt.expectedError {
+				ts.Fatalf("expected error %q, got %q", tt.expectedError, actual)
+			}
+		})
+	}
+}
+
 //-
 
 func TestNewImportsTransition(t *testing.T) {
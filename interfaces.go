@@ -0,0 +1,256 @@
+package nit
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// InterfacesValidator defines the type including the rules used for
+	// validating the methods declared in interface types.
+	InterfacesValidator struct {
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		//-
+		comments *BreakComments
+	}
+
+	// interfaceMethod represents the lines, including doc and trailing
+	// comments, of an interface method or embedded interface.
+	interfaceMethod struct {
+		field *ast.Field
+		start int
+		end   int
+	}
+
+	// textEdit represents the replacement of the bytes between start and end.
+	textEdit struct {
+		start int
+		end   int
+		text  []byte
+	}
+)
+
+// FixInterfaces rewrites the file sorting the methods of the interface types
+// as required by InterfacesValidator, comparing their names using collation.
+// Methods are only moved within their `//-` subgroup, and interfaces declaring
+// multiple methods in the same line are not changed. It returns whether the
+// file was changed.
+func FixInterfaces(filename string, collation Collation) (bool, error) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return false, errors.Wrap(err, "reading file failed")
+	}
+
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return false, errors.Wrap(err, "parsing file failed")
+	}
+
+	var edits []textEdit
+
+	for _, d := range f.Decls {
+		g, ok := d.(*ast.GenDecl)
+		if !ok || g.Tok != token.TYPE {
+			continue
+		}
+
+		for _, s := range g.Specs {
+			if it, ok := s.(*ast.TypeSpec).Type.(*ast.InterfaceType); ok {
+				edits = append(edits, interfaceEdits(it, f.Comments, fset.File(f.Pos()), src, collation)...)
+			}
+		}
+	}
+
+	if len(edits) == 0 {
+		return false, nil
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+
+	for _, e := range edits {
+		src = append(src[:e.start:e.start], append(e.text, src[e.end:]...)...)
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return false, errors.Wrap(err, "reading file failed")
+	}
+
+	if err := ioutil.WriteFile(filename, src, info.Mode()); err != nil {
+		return false, errors.Wrap(err, "writing file failed")
+	}
+
+	return true, nil
+}
+
+// NewInterfacesValidator returns a correctly initialized InterfacesValidator.
+func NewInterfacesValidator(c *BreakComments) *InterfacesValidator {
+	return &InterfacesValidator{comments: c}
+}
+
+// interfaceEdits returns the edits sorting the methods of the interface, each
+// method is moved to the lines of the one it replaces.
+func interfaceEdits(it *ast.InterfaceType, comments []*ast.CommentGroup, file *token.File, src []byte, collation Collation) []textEdit {
+	if it.Methods == nil || len(it.Methods.List) < 2 {
+		return nil
+	}
+
+	var breaks []int
+
+	for _, cg := range comments {
+		for _, c := range cg.List {
+			if c.Pos() > it.Methods.Opening && c.End() < it.Methods.Closing && strings.HasPrefix(c.Text, breakComment) {
+				breaks = append(breaks, file.Line(c.Pos()))
+			}
+		}
+	}
+
+	var (
+		groups  [][]interfaceMethod
+		lastEnd = file.Line(it.Methods.Opening)
+	)
+
+	for _, f := range it.Methods.List {
+		m := interfaceMethod{field: f, start: file.Line(f.Pos()), end: file.Line(f.End())}
+		if f.Doc != nil {
+			m.start = file.Line(f.Doc.Pos())
+		}
+
+		if f.Comment != nil {
+			m.end = file.Line(f.Comment.End())
+		}
+
+		if m.start <= lastEnd || m.end >= file.Line(it.Methods.Closing) {
+			return nil
+		}
+
+		newGroup := len(groups) == 0
+		for _, b := range breaks {
+			if b > lastEnd && b < m.start {
+				newGroup = true
+			}
+		}
+
+		if newGroup {
+			groups = append(groups, nil)
+		}
+
+		groups[len(groups)-1] = append(groups[len(groups)-1], m)
+		lastEnd = m.end
+	}
+
+	text := func(m interfaceMethod) (int, int) {
+		end := len(src)
+		if m.end < file.LineCount() {
+			end = file.Offset(file.LineStart(m.end + 1))
+		}
+
+		return file.Offset(file.LineStart(m.start)), end
+	}
+
+	var res []textEdit
+
+	for _, methods := range groups {
+		sorted := make([]interfaceMethod, len(methods))
+		copy(sorted, methods)
+
+		sort.SliceStable(sorted, func(i, j int) bool {
+			ri, rj := interfaceMethodRank(sorted[i].field), interfaceMethodRank(sorted[j].field)
+			if ri != rj || ri == 0 {
+				return ri < rj
+			}
+
+			return collation.Less(sorted[i].field.Names[0].Name, sorted[j].field.Names[0].Name)
+		})
+
+		for i, m := range methods {
+			if sorted[i].field == m.field {
+				continue
+			}
+
+			start, end := text(m)
+			from, to := text(sorted[i])
+
+			res = append(res, textEdit{start: start, end: end, text: append([]byte(nil), src[from:to]...)})
+		}
+	}
+
+	return res
+}
+
+// interfaceMethodRank returns the position of the method group: embedded
+// interfaces, exported methods and unexported methods.
+func interfaceMethodRank(f *ast.Field) int {
+	switch {
+	case len(f.Names) == 0:
+		return 0
+	case f.Names[0].IsExported():
+		return 1
+	}
+
+	return 2
+}
+
+//-
+
+// Validate makes sure the implemented interface type satisfies the following
+// rules:
+// * Embedded interfaces are declared first,
+// * Sorted exported methods are declared next,
+// * Sorted unexported methods are declared last, and
+// * Both groups can declare their own sorted subgroups.
+func (iv *InterfacesValidator) Validate(v *ast.InterfaceType, fset *token.FileSet) error {
+	if v.Methods == nil {
+		return nil
+	}
+
+	var (
+		lastEnd int
+		methods bool
+		sorted  = sortedNamesValidator{identType: "Interface method", collation: iv.Collation}
+	)
+
+	for _, f := range v.Methods.List {
+		errPrefix := fset.PositionFor(f.Pos(), false).String()
+		line := fset.PositionFor(f.Pos(), false).Line
+
+		if len(f.Names) == 0 {
+			if methods {
+				return errors.Wrap(errors.Errorf("Embedded `%s` must be declared before methods", types.ExprString(f.Type)), errPrefix)
+			}
+
+			lastEnd = fset.PositionFor(f.End(), false).Line
+
+			continue
+		}
+
+		methods = true
+
+		if err := sorted.validateExported(errPrefix, f.Names[0]); err != nil {
+			return err
+		}
+
+		if len(iv.comments.Nested(lastEnd+1, line-1)) > 0 {
+			sorted.last = ""
+		}
+
+		if err := sorted.validateSortedName(errPrefix, f.Names[0]); err != nil {
+			return err
+		}
+
+		lastEnd = fset.PositionFor(f.End(), false).Line
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package nit_test
+
+import (
+	"go/ast"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestFixInterfaces(t *testing.T) {
+	tests := [...]struct {
+		name     string
+		filename string
+		expected string
+		fixed    bool
+	}{
+		{
+			"OK: sort methods",
+			"interfaces_unsorted.go",
+			"interfaces_fixed.go",
+			true,
+		},
+		{
+			"OK: nothing to fix",
+			"interfaces_fixed.go",
+			"interfaces_fixed.go",
+			false,
+		},
+		{
+			"OK: methods in the same line",
+			"interfaces_single_line.go",
+			"interfaces_single_line.go",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			dir, err := ioutil.TempDir("", "nit")
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+			defer os.RemoveAll(dir)
+
+			input, err := ioutil.ReadFile(filepath.Join("testdata", tt.filename))
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			filename := filepath.Join(dir, tt.filename)
+			if err := ioutil.WriteFile(filename, input, 0600); err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			fixed, err := nit.FixInterfaces(filename, nit.CollationBytewise)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			if fixed != tt.fixed {
+				ts.Fatalf("expected fixed %t, got %t", tt.fixed, fixed)
+			}
+
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", tt.expected))
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			actual, err := ioutil.ReadFile(filename)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(string(expected), string(actual)) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(string(expected), string(actual)))
+			}
+
+			n := nit.Nitpicker{SortedInterfaces: true}
+			if err := n.Validate(filename); tt.fixed && err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+		})
+	}
+}
+
+//nolint:dupl
+func TestInterfacesValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		expectedError bool
+	}{
+		{
+			"OK",
+			"interfaces_valid.go",
+			false,
+		},
+		{
+			"Error: embedded",
+			"interfaces_embedded.go",
+			true,
+		},
+		{
+			"Error: sorted",
+			"interfaces_sorted.go",
+			true,
+		},
+		{
+			"Error: grouped",
+			"interfaces_group.go",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			var err error
+
+			f, fset := newParserFile(ts, tt.filename)
+
+			validator := nit.NewInterfacesValidator(nit.NewBreakComments(fset, f.Comments))
+
+			for _, s := range f.Decls {
+				g, ok := s.(*ast.GenDecl)
+				if !ok || g.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range g.Specs {
+					if i, ok := spec.(*ast.TypeSpec).Type.(*ast.InterfaceType); ok && err == nil {
+						err = validator.Validate(i, fset)
+					}
+				}
+			}
+
+			if tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// LimitsValidator defines the type including the rules used for limiting
+	// the size of a file, a value of 0 means no limit; the zero value is ready
+	// to use.
+	LimitsValidator struct {
+		// MaxDecls defines the maximum number of top-level declarations:
+		// types, consts, vars, funcs and methods; imports are not included.
+		MaxDecls int
+		// MaxLines defines the maximum number of lines.
+		MaxLines int
+		// MaxMethods defines the maximum number of methods per type.
+		MaxMethods int
+		// MaxTypes defines the maximum number of types.
+		MaxTypes int
+		//-
+		decls   int
+		types   int
+		methods map[string]int
+	}
+)
+
+// Validate makes sure the implemented declaration, considering all previous
+// declarations, does not exceed MaxDecls, MaxTypes and MaxMethods.
+func (lv *LimitsValidator) Validate(d ast.Decl, fset *token.FileSet) error {
+	errPrefix := fset.PositionFor(d.Pos(), false).String()
+
+	switch t := d.(type) {
+	case *ast.GenDecl:
+		if t.Tok == token.IMPORT {
+			return nil
+		}
+
+		lv.decls += len(t.Specs)
+
+		if t.Tok == token.TYPE {
+			lv.types += len(t.Specs)
+
+			if lv.MaxTypes > 0 && lv.types > lv.MaxTypes {
+				return errors.Wrap(errors.Errorf("file declares more than %d types, consider splitting it", lv.MaxTypes), errPrefix)
+			}
+		}
+	case *ast.FuncDecl:
+		lv.decls++
+
+		if t.Recv != nil && len(t.Recv.List) > 0 {
+			rcvType := t.Recv.List[0].Type
+			if star, ok := rcvType.(*ast.StarExpr); ok {
+				rcvType = star.X
+			}
+
+			if lv.methods == nil {
+				lv.methods = make(map[string]int)
+			}
+
+			name := types.ExprString(rcvType)
+			lv.methods[name]++
+
+			if lv.MaxMethods > 0 && lv.methods[name] > lv.MaxMethods {
+				return errors.Wrap(errors.Errorf("Type `%s` declares more than %d methods, consider splitting it", name, lv.MaxMethods), errPrefix)
+			}
+		}
+	}
+
+	if lv.MaxDecls > 0 && lv.decls > lv.MaxDecls {
+		return errors.Wrap(errors.Errorf("file declares more than %d top-level declarations, consider splitting it", lv.MaxDecls), errPrefix)
+	}
+
+	return nil
+}
+
+// ValidateFile makes sure the file does not exceed MaxLines.
+func (lv *LimitsValidator) ValidateFile(f *ast.File, fset *token.FileSet) error {
+	file := fset.File(f.Pos())
+	if lv.MaxLines > 0 && file.LineCount() > lv.MaxLines {
+		return errors.Wrap(errors.Errorf("file has %d lines, more than %d, consider splitting it", file.LineCount(), lv.MaxLines), file.Name())
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package nit_test
+
+import (
+	"go/ast"
+	"path/filepath"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestLimitsValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		validator     nit.LimitsValidator
+		expectedError bool
+	}{
+		{
+			"OK",
+			nit.LimitsValidator{MaxMethods: 2},
+			false,
+		},
+		{
+			"Error: methods",
+			nit.LimitsValidator{MaxMethods: 1},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			f, fset := newParserFile(ts, "limits.go")
+
+			validator := tt.validator
+
+			var err error
+
+			for _, d := range f.Decls {
+				if _, ok := d.(*ast.FuncDecl); ok {
+					if err = validator.Validate(d, fset); err != nil {
+						break
+					}
+				}
+			}
+
+			if tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestNitpicker_ValidateLimits(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		nitpicker     nit.Nitpicker
+		expectedError bool
+	}{
+		{
+			"OK",
+			nit.Nitpicker{MaxLines: 24, MaxDecls: 7, MaxTypes: 2, MaxMethods: 2},
+			false,
+		},
+		{
+			"Error: lines",
+			nit.Nitpicker{MaxLines: 23},
+			true,
+		},
+		{
+			"Error: decls",
+			nit.Nitpicker{MaxDecls: 6},
+			true,
+		},
+		{
+			"Error: types",
+			nit.Nitpicker{MaxTypes: 1},
+			true,
+		},
+		{
+			"Error: methods",
+			nit.Nitpicker{MaxMethods: 1},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			n := tt.nitpicker
+			n.LocalPath = "github.com/MarioCarrion"
+
+			if err := n.Validate(filepath.Join("testdata", "limits.go")); tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -12,11 +12,20 @@ type (
 	// MethodsValidator defines the type including the rules used for validating
 	// methods.
 	MethodsValidator struct {
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		// TypesOrder indicates methods are grouped by type following the
+		// order used for declaring the types instead of sorting them by name.
+		TypesOrder bool
+		// Visibility defines how exported and unexported names are grouped.
+		Visibility VisibilityPolicy
+		//-
 		comments      *BreakComments
 		sortedTypes   sortedNamesValidator
 		sortedMethods sortedNamesValidator
-		types         map[string]struct{}
+		types         map[string]int
 		lastType      string
+		lastIndex     int
 	}
 
 	// TypesFound defines the type returning the types found in the file.
@@ -31,17 +40,18 @@ func NewMethodsValidator(c *BreakComments, t TypesFound) (*MethodsValidator, err
 		return nil, errors.New("no types found")
 	}
 
-	ts := make(map[string]struct{})
-	for _, tf := range t.Types() {
-		ts[tf] = struct{}{}
+	ts := make(map[string]int)
+	for i, tf := range t.Types() {
+		ts[tf] = i
 	}
 
-	return &MethodsValidator{comments: c, types: ts}, nil
+	return &MethodsValidator{comments: c, types: ts, lastIndex: -1}, nil
 }
 
 // Validate makes sure the implemented methods satisfies the following rules
 // considering all previous declared methods:
 // * Methods for exported types are declared first, then unexported ones,
+// * When TypesOrder is set methods follow the types declaration order instead,
 // * Sorted exported methods are declared first,
 // * Sorted unexported methods are declared next, and
 // * Both groups can declare their own sorted subgroups.
@@ -56,7 +66,8 @@ func (m *MethodsValidator) Validate(v *ast.FuncDecl, fset *token.FileSet) error
 
 	errPrefix := fset.PositionFor(v.Pos(), false).String()
 
-	if _, ok := m.types[rcvType.Name]; !ok {
+	index, ok := m.types[rcvType.Name]
+	if !ok {
 		return errors.Wrap(errors.Errorf("Type `%s` is not defined in the file", rcvType.Name), errPrefix)
 	}
 
@@ -82,13 +93,20 @@ func (m *MethodsValidator) Validate(v *ast.FuncDecl, fset *token.FileSet) error
 
 	if m.lastType != rcvType.Name {
 		m.sortedTypes.identType = "Type"
-		m.sortedMethods = sortedNamesValidator{}
+		m.sortedTypes.collation = m.Collation
+		m.sortedTypes.visibility = m.Visibility
+		m.sortedMethods = sortedNamesValidator{collation: m.Collation, visibility: m.Visibility}
 
-		if err := validateSorted(&m.sortedTypes, rcvType, false); err != nil {
+		if m.TypesOrder {
+			if index < m.lastIndex {
+				return errors.Wrap(errors.Errorf("%s `%s` is not sorted as declared", m.sortedTypes.identType, rcvType.Name), errPrefix)
+			}
+		} else if err := validateSorted(&m.sortedTypes, rcvType, false); err != nil {
 			return err
 		}
 
 		m.lastType = rcvType.Name
+		m.lastIndex = index
 	}
 
 	m.sortedMethods.identType = "Method"
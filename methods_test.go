@@ -13,46 +13,67 @@ func TestMethodsValidator_Validate(t *testing.T) {
 	tests := [...]struct {
 		name          string
 		filename      string
+		typesOrder    bool
 		expectedError bool
 	}{
 		{
 			"OK",
 			"methods_valid.go",
 			false,
+			false,
 		},
 		{
 			"OK: sorted",
 			"methods_sorted.go",
 			false,
+			false,
 		},
 		{
 			"OK: sorted type",
 			"methods_sorted_type_ok.go",
 			false,
+			false,
 		},
 		{
 			"OK: sorted exported/unexported",
 			"methods_sorted_unexported_ok.go",
 			false,
+			false,
+		},
+		{
+			"OK: types order",
+			"methods_types_order.go",
+			true,
+			false,
 		},
 		{
 			"Error: not defined in file",
 			"methods_not_defined.go",
+			false,
 			true,
 		},
 		{
 			"Error: sorted",
 			"methods_sorted_error.go",
+			false,
 			true,
 		},
 		{
 			"Error: sorted type",
 			"methods_sorted_type_error.go",
+			false,
 			true,
 		},
 		{
 			"Error: sorted type comments",
 			"methods_sorted_type_error1.go",
+			false,
+			true,
+		},
+		{
+			"Error: types order",
+			"methods_types_order_error.go",
+			true,
 			true,
 		},
 	}
@@ -101,6 +122,8 @@ func TestMethodsValidator_Validate(t *testing.T) {
 						if err != nil {
 							break
 						}
+
+						validator.TypesOrder = tt.typesOrder
 					}
 
 					if err = validator.Validate(g, fset); err != nil {
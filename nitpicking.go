@@ -4,6 +4,7 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -11,16 +12,60 @@ import (
 type (
 	// Nitpicker defines the linter.
 	Nitpicker struct {
-		LocalPath         string
-		SkipGeneratedFile bool
-		NoLint            bool
+		LocalPath               string
+		ImportPath              string
+		ModulePath              string
+		ImportsGroups           []ImportsGroup
+		ImportsAliases          bool
+		ImportsAliasesMap       map[string]string
+		SkipGeneratedFile       bool
+		NoLint                  bool
+		ConstructorsFirst       bool
+		MethodsTypesOrder       bool
+		SortedInterfaces        bool
+		StructFields            bool
+		SortedStructFields      bool
+		InitFirst               bool
+		MainPlacement           FuncPlacement
+		TestMainFirst           bool
+		SingleInit              bool
+		TestProfile             bool
+		SortedTests             bool
+		Directives              bool
+		GenerateFile            string
+		Enums                   bool
+		BlocksPolicies          map[FileSection]BlocksPolicy
+		ParenthesesThresholds   ParenthesesThresholds
+		Collation               Collation
+		VisibilityPolicies      map[FileSection]VisibilityPolicy
+		DependencyOrder         bool
+		DeclarationOrder        bool
+		ReceiverNames           bool
+		ReceiverMaxLength       int
+		ReceiverKinds           bool
+		ReceiverKindsExceptions []string
+		MaxLines                int
+		MaxDecls                int
+		MaxTypes                int
+		MaxMethods              int
 		//-
 		fset       *token.FileSet
+		pkg        string
+		test       bool
 		fsm        *FileSectionMachine
 		comments   *BreakComments
+		blocks     map[FileSection]token.Pos
+		ivalidator *ImportsValidator
 		tvalidator *TypesValidator
+		kvalidator *ConstsValidator
+		rvalidator *VarsValidator
+		evalidator *EnumsValidator
+		svalidator *SpecialFuncsValidator
+		cvalidator *ConstructorsValidator
+		xvalidator *TestFuncsValidator
 		fvalidator *FuncsValidator
 		mvalidator *MethodsValidator
+		nvalidator *ReceiversValidator
 	}
 )
 
@@ -33,7 +78,7 @@ func (v *Nitpicker) Validate(filename string) error {
 		return errors.Wrap(err, "parsing file failed")
 	}
 
-	v.comments = NewBreakComments(v.fset, f.Comments)
+	v.comments = NewFileBreakComments(v.fset, f)
 	if v.comments.HasGeneratedCode() && v.SkipGeneratedFile {
 		return nil
 	}
@@ -42,15 +87,94 @@ func (v *Nitpicker) Validate(filename string) error {
 		return nil
 	}
 
+	if v.Directives {
+		validator := DirectivesValidator{GenerateFile: v.GenerateFile, VarsParenthesesThreshold: v.ParenthesesThresholds[FileSectionVars]}
+		if err := validator.Validate(filename, f, v.fset); err != nil {
+			return err
+		}
+	}
+
+	v.pkg = f.Name.Name
+	v.test = strings.HasSuffix(filename, "_test.go")
+
+	v.svalidator = &SpecialFuncsValidator{
+		Placements: make(map[string]FuncPlacement),
+		SingleInit: v.SingleInit,
+	}
+
+	if v.InitFirst {
+		v.svalidator.Placements["init"] = FuncPlacementFirst
+	}
+
+	if f.Name.Name == "main" {
+		v.svalidator.Placements["main"] = v.MainPlacement
+	}
+
+	if v.TestMainFirst && v.test {
+		v.svalidator.Placements["TestMain"] = FuncPlacementFirst
+	}
+
+	var limits *LimitsValidator
+
+	if v.MaxLines > 0 || v.MaxDecls > 0 || v.MaxTypes > 0 || v.MaxMethods > 0 {
+		limits = &LimitsValidator{
+			MaxDecls:   v.MaxDecls,
+			MaxLines:   v.MaxLines,
+			MaxMethods: v.MaxMethods,
+			MaxTypes:   v.MaxTypes,
+		}
+
+		if err := limits.ValidateFile(f, v.fset); err != nil {
+			return err
+		}
+	}
+
 	for _, s := range f.Decls {
 		if err := v.validateToken(s); err != nil {
 			return err
 		}
+
+		if limits != nil {
+			if err := limits.Validate(s, v.fset); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// validateBlocks makes sure the declaration satisfies the blocks policy of the
+// section, it returns the policy to use; sections allowing unparenthesized
+// declarations allow many blocks instead of one.
+func (v *Nitpicker) validateBlocks(section FileSection, d *ast.GenDecl) (BlocksPolicy, error) {
+	policy, ok := v.BlocksPolicies[section]
+	if !ok {
+		policy = DefaultBlocksPolicies()[section]
+	}
+
+	if policy == BlocksPolicyOne && v.ParenthesesThresholds[section] > 0 {
+		policy = BlocksPolicyMany
+	}
+
+	if v.blocks == nil {
+		v.blocks = make(map[FileSection]token.Pos)
+	}
+
+	first, ok := v.blocks[section]
+	if !ok {
+		v.blocks[section] = d.Pos()
+		return policy, nil
+	}
+
+	if policy == BlocksPolicyOne {
+		return policy, errors.Wrap(errors.Errorf("only one `%s` section block is allowed per file, first one declared at %s",
+			d.Tok, v.fset.PositionFor(first, false)), v.fset.PositionFor(d.Pos(), false).String())
+	}
+
+	return policy, nil
+}
+
 //nolint:gocyclo,funlen
 func (v *Nitpicker) validateToken(d ast.Decl) error {
 	var (
@@ -90,33 +214,161 @@ func (v *Nitpicker) validateToken(d ast.Decl) error {
 
 	switch nextState {
 	case FileSectionImports:
-		validator := NewImportsValidator(v.LocalPath)
-		if err := validator.Validate(genDecl, v.fset); err != nil {
+		if isCgoImport(genDecl) {
+			return nil
+		}
+
+		policy, err := v.validateBlocks(nextState, genDecl)
+		if err != nil {
 			return err
 		}
+
+		if v.ivalidator != nil && policy != BlocksPolicyManySorted {
+			// unsorted blocks start their own groups, imported paths are kept.
+			v.ivalidator.fsm = nil
+		}
+
+		if v.ivalidator == nil {
+			validator := NewImportsValidator(v.LocalPath)
+			validator.Groups = v.ImportsGroups
+			validator.ModulePath = v.ModulePath
+			validator.ParenthesesThreshold = v.ParenthesesThresholds[nextState]
+
+			if v.TestProfile && strings.HasSuffix(v.pkg, "_test") {
+				validator.TestedPath = v.ImportPath
+			}
+
+			v.ivalidator = &validator
+		}
+
+		if err := v.ivalidator.Validate(genDecl, v.fset); err != nil {
+			return err
+		}
+
+		if v.ImportsAliases {
+			avalidator := ImportsAliasesValidator{Aliases: v.ImportsAliasesMap, AllowDotImports: v.test}
+			if err := avalidator.Validate(genDecl, v.fset); err != nil {
+				return err
+			}
+		}
 	case FileSectionTypes:
-		if v.tvalidator != nil {
-			return errors.New("only one `type` section block is allowed per file")
+		policy, err := v.validateBlocks(nextState, genDecl)
+		if err != nil {
+			return err
 		}
 
-		v.tvalidator = NewTypesValidator(v.comments)
+		if v.tvalidator == nil {
+			v.tvalidator = NewTypesValidator(v.comments)
+			v.tvalidator.ParenthesesThreshold = v.ParenthesesThresholds[nextState]
+			v.tvalidator.Collation = v.Collation
+			v.tvalidator.Visibility = v.VisibilityPolicies[nextState]
+		} else {
+			v.tvalidator.reset(policy)
+		}
 
 		if err := v.tvalidator.Validate(genDecl, v.fset); err != nil {
 			return err
 		}
+
+		if err := v.validateTypeSpecs(genDecl); err != nil {
+			return err
+		}
 	case FileSectionConsts:
-		validator := &ConstsValidator{}
-		if err := validator.Validate(genDecl, v.fset); err != nil {
+		if v.Enums {
+			if v.evalidator == nil {
+				v.evalidator = NewEnumsValidator(v.tvalidator)
+			}
+
+			if err := v.evalidator.Validate(genDecl, v.fset); err != nil {
+				return err
+			}
+		}
+
+		policy, err := v.validateBlocks(nextState, genDecl)
+		if err != nil {
+			return err
+		}
+
+		if v.kvalidator == nil {
+			v.kvalidator = &ConstsValidator{
+				Collation:            v.Collation,
+				DeclarationOrder:     v.DeclarationOrder,
+				DependencyOrder:      v.DependencyOrder,
+				ParenthesesThreshold: v.ParenthesesThresholds[nextState],
+				Visibility:           v.VisibilityPolicies[nextState],
+			}
+		} else {
+			v.kvalidator.reset(policy)
+		}
+
+		if err := v.kvalidator.Validate(genDecl, v.fset); err != nil {
 			return err
 		}
 	case FileSectionVars:
-		validator := &VarsValidator{}
-		if err := validator.Validate(genDecl, v.fset); err != nil {
+		policy, err := v.validateBlocks(nextState, genDecl)
+		if err != nil {
+			return err
+		}
+
+		if v.rvalidator == nil {
+			v.rvalidator = &VarsValidator{
+				Collation:            v.Collation,
+				DeclarationOrder:     v.DeclarationOrder,
+				DependencyOrder:      v.DependencyOrder,
+				ParenthesesThreshold: v.ParenthesesThresholds[nextState],
+				Visibility:           v.VisibilityPolicies[nextState],
+			}
+		} else {
+			v.rvalidator.reset(policy)
+		}
+
+		if err := v.rvalidator.Validate(genDecl, v.fset); err != nil {
 			return err
 		}
 	case FileSectionFuncs:
+		if err := v.svalidator.Validate(funcDecl, v.fset); err != nil {
+			return err
+		}
+
+		if v.svalidator.IsSpecial(funcDecl) {
+			return nil
+		}
+
+		if v.TestProfile && v.test {
+			if v.xvalidator == nil {
+				v.xvalidator = NewTestFuncsValidator(v.comments)
+				v.xvalidator.Sorted = v.SortedTests
+				v.xvalidator.Collation = v.Collation
+			}
+
+			if err := v.xvalidator.Validate(funcDecl, v.fset); err != nil {
+				return err
+			}
+
+			if !v.xvalidator.IsHelper(funcDecl) {
+				return nil
+			}
+		}
+
+		if v.ConstructorsFirst {
+			if v.cvalidator == nil {
+				v.cvalidator = NewConstructorsValidator(v.tvalidator)
+				v.cvalidator.Collation = v.Collation
+			}
+
+			if err := v.cvalidator.Validate(funcDecl, v.fset); err != nil {
+				return err
+			}
+
+			if v.cvalidator.IsConstructor(funcDecl) {
+				return nil
+			}
+		}
+
 		if v.fvalidator == nil {
 			v.fvalidator = NewFuncsValidator(v.comments)
+			v.fvalidator.Collation = v.Collation
+			v.fvalidator.Visibility = v.VisibilityPolicies[nextState]
 		}
 
 		if err := v.fvalidator.Validate(funcDecl, v.fset); err != nil {
@@ -130,11 +382,64 @@ func (v *Nitpicker) validateToken(d ast.Decl) error {
 			}
 
 			v.mvalidator = mvalidator
+			v.mvalidator.TypesOrder = v.MethodsTypesOrder
+			v.mvalidator.Collation = v.Collation
+			v.mvalidator.Visibility = v.VisibilityPolicies[nextState]
 		}
 
 		if err := v.mvalidator.Validate(funcDecl, v.fset); err != nil {
 			return err
 		}
+
+		if v.ReceiverNames || v.ReceiverKinds || v.ReceiverMaxLength > 0 {
+			if v.nvalidator == nil {
+				v.nvalidator = &ReceiversValidator{
+					Exceptions: v.ReceiverKindsExceptions,
+					Kinds:      v.ReceiverKinds,
+					MaxLength:  v.ReceiverMaxLength,
+					Names:      v.ReceiverNames,
+				}
+			}
+
+			if err := v.nvalidator.Validate(funcDecl, v.fset); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *Nitpicker) validateTypeSpecs(d *ast.GenDecl) error {
+	ivalidator := NewInterfacesValidator(v.comments)
+	ivalidator.Collation = v.Collation
+
+	svalidator := NewStructsValidator(v.comments)
+	svalidator.Sorted = v.SortedStructFields
+	svalidator.Collation = v.Collation
+
+	for _, s := range d.Specs {
+		t, ok := s.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+
+		var err error
+
+		switch e := t.Type.(type) {
+		case *ast.InterfaceType:
+			if v.SortedInterfaces {
+				err = ivalidator.Validate(e, v.fset)
+			}
+		case *ast.StructType:
+			if v.StructFields || v.SortedStructFields {
+				err = svalidator.Validate(e, v.fset)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -18,6 +18,11 @@ func TestNitpicker_Validate(t *testing.T) {
 			"nitpicker_valid.go",
 			false,
 		},
+		{
+			"OK: cgo",
+			"nitpicker_cgo.go",
+			false,
+		},
 		{
 			"Error",
 			"nitpicker_error_type.go",
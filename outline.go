@@ -0,0 +1,219 @@
+package nit
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// FileOutline represents the sections found in a file, in order, as
+	// detected by NewGenDeclFileSection and NewFuncDeclFileSection.
+	FileOutline struct {
+		Filename string           `json:"filename"`
+		Sections []OutlineSection `json:"sections"`
+	}
+
+	// OutlineDecl represents a declaration found in a file, State is the
+	// FileSectionMachine state after the declaration, Error is set when the
+	// declaration breaks the expected order.
+	OutlineDecl struct {
+		Kind  string   `json:"kind"`
+		Names []string `json:"names"`
+		Start int      `json:"start"`
+		End   int      `json:"end"`
+		State string   `json:"state"`
+		Error string   `json:"error,omitempty"`
+	}
+
+	// OutlineSection represents consecutive declarations of the same section,
+	// including the lines of the `//-` comments found in it.
+	OutlineSection struct {
+		Section      string        `json:"section"`
+		Start        int           `json:"start"`
+		End          int           `json:"end"`
+		Breaks       []int         `json:"breaks,omitempty"`
+		NestedBreaks []int         `json:"nested_breaks,omitempty"`
+		Decls        []OutlineDecl `json:"decls"`
+	}
+)
+
+// NewFileOutline returns the outline of the received file.
+func NewFileOutline(filename string) (*FileOutline, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing file failed")
+	}
+
+	comments := NewFileBreakComments(fset, f)
+
+	res := FileOutline{Filename: filename}
+
+	var (
+		fsm   *FileSectionMachine
+		state string
+	)
+
+	for _, d := range f.Decls {
+		var (
+			section FileSection
+			decl    OutlineDecl
+		)
+
+		switch t := d.(type) {
+		case *ast.GenDecl:
+			section, err = NewGenDeclFileSection(t)
+			decl = outlineGenDecl(t)
+		case *ast.FuncDecl:
+			section, err = NewFuncDeclFileSection(t)
+			decl = outlineFuncDecl(t)
+		default:
+			err = errors.New("unknown declaration state")
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, fset.PositionFor(d.Pos(), false).String())
+		}
+
+		if fsm == nil {
+			if fsm, err = NewFileSectionMachine(section); err != nil {
+				return nil, errors.Wrap(err, fset.PositionFor(d.Pos(), false).String())
+			}
+		}
+
+		if err := fsm.Transition(section); err != nil {
+			decl.Error = err.Error()
+		} else {
+			state = section.String()
+		}
+
+		decl.Start = fset.PositionFor(d.Pos(), false).Line
+		decl.End = fset.PositionFor(d.End(), false).Line
+		decl.State = state
+
+		last := len(res.Sections) - 1
+		if last == -1 || res.Sections[last].Section != section.String() {
+			res.Sections = append(res.Sections, OutlineSection{Section: section.String(), Start: decl.Start})
+			last++
+		}
+
+		res.Sections[last].End = decl.End
+		res.Sections[last].Decls = append(res.Sections[last].Decls, decl)
+	}
+
+	for i, s := range res.Sections {
+		for _, line := range comments.Lines() {
+			if line >= s.Start && line <= s.End {
+				res.Sections[i].Breaks = append(res.Sections[i].Breaks, line)
+			}
+		}
+
+		res.Sections[i].NestedBreaks = comments.Nested(s.Start, s.End)
+	}
+
+	return &res, nil
+}
+
+// outlineBreaks returns the text representing the lines of the `//-`
+// comments, when any.
+func outlineBreaks(breaks, nested []int) string {
+	if len(breaks) == 0 && len(nested) == 0 {
+		return ""
+	}
+
+	join := func(lines []int) string {
+		values := make([]string, len(lines))
+		for i, l := range lines {
+			values[i] = strconv.Itoa(l)
+		}
+
+		return strings.Join(values, ", ")
+	}
+
+	res := " //- " + join(breaks)
+	if len(nested) > 0 {
+		res += " (nested " + join(nested) + ")"
+	}
+
+	return res
+}
+
+func outlineFuncDecl(v *ast.FuncDecl) OutlineDecl {
+	if v.Recv == nil || len(v.Recv.List) == 0 {
+		return OutlineDecl{Kind: "func", Names: []string{v.Name.Name}}
+	}
+
+	rcvType := v.Recv.List[0].Type
+	if star, ok := rcvType.(*ast.StarExpr); ok {
+		rcvType = star.X
+	}
+
+	name := v.Name.Name
+	if ident, ok := rcvType.(*ast.Ident); ok {
+		name = ident.Name + "." + name
+	}
+
+	return OutlineDecl{Kind: "method", Names: []string{name}}
+}
+
+func outlineGenDecl(v *ast.GenDecl) OutlineDecl {
+	res := OutlineDecl{Kind: v.Tok.String()}
+
+	for _, s := range v.Specs {
+		switch t := s.(type) {
+		case *ast.ImportSpec:
+			path, _ := strconv.Unquote(t.Path.Value)
+			res.Names = append(res.Names, path)
+		case *ast.TypeSpec:
+			res.Names = append(res.Names, t.Name.Name)
+		case *ast.ValueSpec:
+			for _, n := range t.Names {
+				res.Names = append(res.Names, n.Name)
+			}
+		}
+	}
+
+	return res
+}
+
+//-
+
+// String returns the outline as a text tree, declarations breaking the
+// expected order are marked with `!`.
+func (o *FileOutline) String() string {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, o.Filename)
+
+	for i, s := range o.Sections {
+		branch, indent := "├──", "│   "
+		if i == len(o.Sections)-1 {
+			branch, indent = "└──", "    "
+		}
+
+		fmt.Fprintf(&b, "%s %s %d-%d%s\n", branch, s.Section, s.Start, s.End, outlineBreaks(s.Breaks, s.NestedBreaks))
+
+		for j, d := range s.Decls {
+			leaf := "├──"
+			if j == len(s.Decls)-1 {
+				leaf = "└──"
+			}
+
+			mark := ""
+			if d.Error != "" {
+				mark = " ! " + d.Error
+			}
+
+			fmt.Fprintf(&b, "%s%s %d-%d %s %s [%s]%s\n", indent, leaf, d.Start, d.End, d.Kind, strings.Join(d.Names, ", "), d.State, mark)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,114 @@
+package nit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestFileOutline_String(t *testing.T) {
+	filename := filepath.Join("testdata", "outline.go")
+
+	o, err := nit.NewFileOutline(filename)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := filename + "\n" +
+		"├── imports 3-5\n" +
+		"│   └── 3-5 import fmt [imports]\n" +
+		"├── types 7-11 //- 9\n" +
+		"│   └── 7-11 type OutlineA, OutlineB [types]\n" +
+		"├── funcs 13-15\n" +
+		"│   └── 13-15 func OutlineFunc [funcs]\n" +
+		"├── consts 17-19\n" +
+		"│   └── 17-19 const OutlineConst [funcs] ! `consts` is invalid, next one must be `functions` or `methods`\n" +
+		"└── methods 21-21\n" +
+		"    └── 21-21 method OutlineA.Method [methods]\n"
+
+	if actual := o.String(); !cmp.Equal(expected, actual) {
+		t.Fatalf("expected values do not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestNewFileOutline(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		expected      *nit.FileOutline
+		expectedError bool
+	}{
+		{
+			"OK",
+			"outline.go",
+			&nit.FileOutline{
+				Filename: filepath.Join("testdata", "outline.go"),
+				Sections: []nit.OutlineSection{
+					{
+						Section: "imports",
+						Start:   3,
+						End:     5,
+						Decls:   []nit.OutlineDecl{{Kind: "import", Names: []string{"fmt"}, Start: 3, End: 5, State: "imports"}},
+					},
+					{
+						Section: "types",
+						Start:   7,
+						End:     11,
+						Breaks:  []int{9},
+						Decls:   []nit.OutlineDecl{{Kind: "type", Names: []string{"OutlineA", "OutlineB"}, Start: 7, End: 11, State: "types"}},
+					},
+					{
+						Section: "funcs",
+						Start:   13,
+						End:     15,
+						Decls:   []nit.OutlineDecl{{Kind: "func", Names: []string{"OutlineFunc"}, Start: 13, End: 15, State: "funcs"}},
+					},
+					{
+						Section: "consts",
+						Start:   17,
+						End:     19,
+						Decls: []nit.OutlineDecl{
+							{
+								Kind:  "const",
+								Names: []string{"OutlineConst"},
+								Start: 17,
+								End:   19,
+								State: "funcs",
+								Error: "`consts` is invalid, next one must be `functions` or `methods`",
+							},
+						},
+					},
+					{
+						Section: "methods",
+						Start:   21,
+						End:     21,
+						Decls:   []nit.OutlineDecl{{Kind: "method", Names: []string{"OutlineA.Method"}, Start: 21, End: 21, State: "methods"}},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"Error: missing file",
+			"outline_missing.go",
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, err := nit.NewFileOutline(filepath.Join("testdata", tt.filename))
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+
+			if !cmp.Equal(tt.expected, actual) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package nit
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// PackageValidator defines the type including the rules used for
+	// validating the files of a package as a whole.
+	PackageValidator struct {
+		// FilenameType indicates files declaring exported types are named after
+		// one of them, using lowercase or snake case: `user.go` or
+		// `http_server.go` for `HTTPServer`; TypesFiles are excluded.
+		FilenameType bool
+		// NoLint indicates files including the "do not lint nit" expression are
+		// excluded.
+		NoLint bool
+		// PrimaryType indicates each file declares at most one exported type;
+		// TypesFiles are excluded.
+		PrimaryType bool
+		// SkipGeneratedFile indicates files including the "code generated
+		// expression" are excluded.
+		SkipGeneratedFile bool
+		// TypesFiles defines the only file names allowed to declare exported
+		// types, when empty all files are allowed.
+		TypesFiles []string
+	}
+)
+
+// typeFilenames returns the file names matching the type name: lowercase and
+// snake case.
+func typeFilenames(name string) []string {
+	words := nameWords(name)
+
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+
+	if len(words) == 1 {
+		return []string{words[0] + ".go"}
+	}
+
+	return []string{strings.Join(words, "") + ".go", strings.Join(words, "_") + ".go"}
+}
+
+//-
+
+// Validate makes sure the files, excluding test files and the ones skipped by
+// SkipGeneratedFile and NoLint, satisfy the following rules:
+// * When TypesFiles is set, exported types are declared in those files only,
+// * When PrimaryType is set, each file declares one exported type maximum, and
+// * When FilenameType is set, files are named after one of their exported
+// types.
+func (pv *PackageValidator) Validate(filenames []string) error {
+	fset := token.NewFileSet()
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			return errors.Wrap(err, "parsing file failed")
+		}
+
+		comments := NewFileBreakComments(fset, f)
+		if comments.HasGeneratedCode() && pv.SkipGeneratedFile {
+			continue
+		}
+
+		if comments.HasNoLintNit() && pv.NoLint {
+			continue
+		}
+
+		if err := pv.validateFile(filepath.Base(filename), f, fset); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pv *PackageValidator) validateFile(name string, f *ast.File, fset *token.FileSet) error {
+	var exported []*ast.Ident
+
+	for _, d := range f.Decls {
+		g, ok := d.(*ast.GenDecl)
+		if !ok || g.Tok != token.TYPE {
+			continue
+		}
+
+		for _, s := range g.Specs {
+			if t, ok := s.(*ast.TypeSpec); ok && t.Name.IsExported() {
+				exported = append(exported, t.Name)
+			}
+		}
+	}
+
+	if len(exported) == 0 {
+		return nil
+	}
+
+	if len(pv.TypesFiles) > 0 {
+		for _, t := range pv.TypesFiles {
+			if t == name {
+				return nil
+			}
+		}
+
+		return errors.Wrap(errors.Errorf("Type `%s` must be declared in %s", exported[0].Name, strings.Join(pv.TypesFiles, " or ")),
+			fset.PositionFor(exported[0].Pos(), false).String())
+	}
+
+	if pv.PrimaryType && len(exported) > 1 {
+		return errors.Wrap(errors.Errorf("Type `%s` must be declared in its own file, `%s` is the primary type", exported[1].Name, exported[0].Name),
+			fset.PositionFor(exported[1].Pos(), false).String())
+	}
+
+	if !pv.FilenameType {
+		return nil
+	}
+
+	for _, t := range exported {
+		for _, expected := range typeFilenames(t.Name) {
+			if expected == name {
+				return nil
+			}
+		}
+	}
+
+	return errors.Wrap(errors.Errorf("File must be named after type `%s`: %s", exported[0].Name, strings.Join(typeFilenames(exported[0].Name), " or ")),
+		fset.PositionFor(exported[0].Pos(), false).String())
+}
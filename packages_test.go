@@ -0,0 +1,85 @@
+package nit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestPackageValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filenames     []string
+		validator     nit.PackageValidator
+		expectedError bool
+	}{
+		{
+			"OK: primary type",
+			[]string{"user.go", "http_server.go", "helpers.go", "item.go", "packages_test.go"},
+			nit.PackageValidator{PrimaryType: true},
+			false,
+		},
+		{
+			"OK: filename type",
+			[]string{"user.go", "http_server.go", "helpers.go", "packages_test.go"},
+			nit.PackageValidator{FilenameType: true},
+			false,
+		},
+		{
+			"OK: types files",
+			[]string{"types.go", "helpers.go", "packages_test.go"},
+			nit.PackageValidator{FilenameType: true, PrimaryType: true, TypesFiles: []string{"types.go"}},
+			false,
+		},
+		{
+			"OK: generated file skipped",
+			[]string{"user.go", "zz_generated.go"},
+			nit.PackageValidator{FilenameType: true, PrimaryType: true, SkipGeneratedFile: true},
+			false,
+		},
+		{
+			"OK: nolint file skipped",
+			[]string{"user.go", "nolint.go"},
+			nit.PackageValidator{NoLint: true, PrimaryType: true},
+			false,
+		},
+		{
+			"Error: primary type",
+			[]string{"user.go", "types.go"},
+			nit.PackageValidator{PrimaryType: true},
+			true,
+		},
+		{
+			"Error: filename type",
+			[]string{"user.go", "item.go"},
+			nit.PackageValidator{FilenameType: true},
+			true,
+		},
+		{
+			"Error: types files",
+			[]string{"types.go", "user.go"},
+			nit.PackageValidator{TypesFiles: []string{"types.go", "models.go"}},
+			true,
+		},
+		{
+			"Error: generated file",
+			[]string{"user.go", "zz_generated.go"},
+			nit.PackageValidator{PrimaryType: true},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			filenames := make([]string, len(tt.filenames))
+			for i, f := range tt.filenames {
+				filenames[i] = filepath.Join("testdata", "packages", f)
+			}
+
+			if err := tt.validator.Validate(filenames); tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package nit
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// ParenthesesThresholds defines, per section, the number of specs allowed
+	// in a declaration without parentheses; a threshold of 0, the default,
+	// requires them always. Only imports, types, consts and vars are valid
+	// sections; a threshold above 0 allows many blocks of the section when its
+	// blocks policy is one.
+	ParenthesesThresholds map[FileSection]int
+)
+
+// FixParentheses rewrites the file adding the parentheses to the declarations
+// requiring them, and removing them from the single spec declarations not
+// requiring them, according to the received thresholds. Declarations
+// including comments between the parentheses, other than the trailing comment
+// of the spec, are not unparenthesized. It returns whether the file was
+// changed.
+func FixParentheses(filename string, thresholds ParenthesesThresholds) (bool, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return false, errors.Wrap(err, "parsing file failed")
+	}
+
+	var fixed bool
+
+	for _, d := range f.Decls {
+		g, ok := d.(*ast.GenDecl)
+		if !ok || isCgoImport(g) {
+			continue
+		}
+
+		section, err := NewGenDeclFileSection(g)
+		if err != nil {
+			return false, errors.Wrap(err, fset.PositionFor(g.Pos(), false).String())
+		}
+
+		threshold := thresholds[section]
+
+		switch {
+		case !g.Lparen.IsValid() && len(g.Specs) > threshold:
+			g.Rparen = g.End()
+			if c := specComment(g.Specs[0]); c != nil {
+				g.Rparen = c.End()
+			}
+
+			g.Lparen = g.TokPos + token.Pos(len(g.Tok.String()))
+			fixed = true
+		case g.Lparen.IsValid() && len(g.Specs) == 1 && threshold > 0 && !hasComments(f, g):
+			g.Lparen, g.Rparen = token.NoPos, token.NoPos
+			fixed = true
+		}
+	}
+
+	if !fixed {
+		return false, nil
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return false, errors.Wrap(err, "reading file failed")
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return false, errors.Wrap(err, "formatting file failed")
+	}
+
+	if err := ioutil.WriteFile(filename, buf.Bytes(), info.Mode()); err != nil {
+		return false, errors.Wrap(err, "writing file failed")
+	}
+
+	return true, nil
+}
+
+// NewParenthesesThresholds returns the thresholds defined in the received
+// comma separated value, using the format `section=N`, where section is one
+// of imports, types, consts or vars.
+func NewParenthesesThresholds(s string) (ParenthesesThresholds, error) {
+	res := make(ParenthesesThresholds)
+
+	if s == "" {
+		return res, nil
+	}
+
+	for _, value := range strings.Split(s, ",") {
+		values := strings.SplitN(strings.TrimSpace(value), "=", 2)
+		if len(values) != 2 {
+			return nil, errors.Errorf("invalid parentheses threshold: %s", value)
+		}
+
+		section, ok := newDeclFileSection(values[0])
+		if !ok {
+			return nil, errors.Errorf("invalid parentheses threshold section: %s", values[0])
+		}
+
+		threshold, err := strconv.Atoi(values[1])
+		if err != nil || threshold < 0 {
+			return nil, errors.Errorf("invalid parentheses threshold value: %s", values[1])
+		}
+
+		res[section] = threshold
+	}
+
+	return res, nil
+}
+
+func hasComments(f *ast.File, g *ast.GenDecl) bool {
+	trailing := specComment(g.Specs[0])
+
+	for _, c := range f.Comments {
+		if c != trailing && c.Pos() > g.Lparen && c.End() < g.Rparen {
+			return true
+		}
+	}
+
+	return false
+}
+
+func specComment(s ast.Spec) *ast.CommentGroup {
+	switch t := s.(type) {
+	case *ast.ImportSpec:
+		return t.Comment
+	case *ast.TypeSpec:
+		return t.Comment
+	case *ast.ValueSpec:
+		return t.Comment
+	}
+
+	return nil
+}
+
+// validateParentheses makes sure the declaration is parenthesized when it
+// includes more specs than the threshold.
+func validateParentheses(v *ast.GenDecl, threshold int, fset *token.FileSet) error {
+	if !v.Lparen.IsValid() && len(v.Specs) > threshold {
+		return errors.Wrap(errors.New("expected parenthesized declaration"), fset.PositionFor(v.Pos(), false).String())
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package nit_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestFixParentheses(t *testing.T) {
+	tests := [...]struct {
+		name       string
+		filename   string
+		thresholds string
+		expected   string
+		fixed      bool
+	}{
+		{
+			"OK: add parentheses",
+			"parentheses_single.go",
+			"",
+			"parentheses_paren.go",
+			true,
+		},
+		{
+			"OK: remove parentheses",
+			"parentheses_paren.go",
+			"imports=1,types=1,consts=1,vars=1",
+			"parentheses_single.go",
+			true,
+		},
+		{
+			"OK: nothing to fix",
+			"parentheses_paren.go",
+			"",
+			"parentheses_paren.go",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			thresholds, err := nit.NewParenthesesThresholds(tt.thresholds)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			dir, err := ioutil.TempDir("", "nit")
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+			defer os.RemoveAll(dir)
+
+			input, err := ioutil.ReadFile(filepath.Join("testdata", tt.filename))
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			filename := filepath.Join(dir, tt.filename)
+			if err := ioutil.WriteFile(filename, input, 0600); err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			fixed, err := nit.FixParentheses(filename, thresholds)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			if fixed != tt.fixed {
+				ts.Fatalf("expected fixed %t, got %t", tt.fixed, fixed)
+			}
+
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", tt.expected))
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			actual, err := ioutil.ReadFile(filename)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(string(expected), string(actual)) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(string(expected), string(actual)))
+			}
+		})
+	}
+}
+
+func TestNewParenthesesThresholds(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		input         string
+		expected      nit.ParenthesesThresholds
+		expectedError bool
+	}{
+		{
+			"OK: default",
+			"",
+			nit.ParenthesesThresholds{},
+			false,
+		},
+		{
+			"OK",
+			"imports=1, vars=2",
+			nit.ParenthesesThresholds{
+				nit.FileSectionImports: 1,
+				nit.FileSectionVars:    2,
+			},
+			false,
+		},
+		{
+			"Error: format",
+			"types",
+			nil,
+			true,
+		},
+		{
+			"Error: section",
+			"funcs=1",
+			nil,
+			true,
+		},
+		{
+			"Error: value",
+			"types=-1",
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, err := nit.NewParenthesesThresholds(tt.input)
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+
+			if !cmp.Equal(tt.expected, actual) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+func TestNitpicker_ValidateParentheses(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		thresholds    string
+		expectedError bool
+	}{
+		{
+			"OK: parenthesized",
+			"parentheses_paren.go",
+			"",
+			false,
+		},
+		{
+			"OK: single",
+			"parentheses_single.go",
+			"imports=1,types=1,consts=1,vars=1",
+			false,
+		},
+		{
+			"OK: single interface with subgroups",
+			"parentheses_interface.go",
+			"types=1",
+			false,
+		},
+		{
+			"OK: single struct with subgroups",
+			"parentheses_struct.go",
+			"types=1",
+			false,
+		},
+		{
+			"OK: many single",
+			"parentheses_many.go",
+			"types=1,vars=1",
+			false,
+		},
+		{
+			"Error: many single",
+			"parentheses_many.go",
+			"",
+			true,
+		},
+		{
+			"Error: single",
+			"parentheses_single.go",
+			"",
+			true,
+		},
+		{
+			"Error: single vars",
+			"parentheses_single.go",
+			"imports=1,types=1,consts=1",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			thresholds, err := nit.NewParenthesesThresholds(tt.thresholds)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			n := nit.Nitpicker{
+				LocalPath:             "github.com/MarioCarrion",
+				ParenthesesThresholds: thresholds,
+				SortedInterfaces:      true,
+				SortedStructFields:    true,
+			}
+
+			if err := n.Validate(filepath.Join("testdata", tt.filename)); tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
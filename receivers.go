@@ -0,0 +1,123 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// ReceiversValidator defines the type including the rules used for
+	// validating methods receivers, the zero value is ready to use.
+	ReceiversValidator struct {
+		// Exceptions defines the methods allowed to use a receiver kind, pointer
+		// or value, different than the other methods of the type.
+		Exceptions []string
+		// Kinds indicates all methods of a type use pointer receivers or value
+		// receivers.
+		Kinds bool
+		// MaxLength defines the maximum length of receiver names, 0 means no
+		// limit.
+		MaxLength int
+		// Names indicates all methods of a type use the same receiver name,
+		// `this` and `self` are not allowed.
+		Names bool
+		//-
+		names    map[string]string
+		pointers map[string]bool
+	}
+)
+
+// Validate makes sure the receiver of the implemented method satisfies the
+// following rules considering all previous declared methods:
+// * When Names is set, it uses the same name used by the other methods of
+// the type, and it is not named `this` or `self`,
+// * When MaxLength is set, its name is not longer than MaxLength, and
+// * When Kinds is set, it is a pointer if the other methods of the type use
+// pointers, or a value otherwise, except for the methods in Exceptions.
+func (rv *ReceiversValidator) Validate(v *ast.FuncDecl, fset *token.FileSet) error {
+	if v.Recv == nil || len(v.Recv.List) == 0 {
+		return nil
+	}
+
+	if rv.names == nil {
+		rv.names = make(map[string]string)
+	}
+
+	if rv.pointers == nil {
+		rv.pointers = make(map[string]bool)
+	}
+
+	errPrefix := fset.PositionFor(v.Pos(), false).String()
+
+	field := v.Recv.List[0]
+
+	rcvType := field.Type
+	star, pointer := rcvType.(*ast.StarExpr)
+	if pointer {
+		rcvType = star.X
+	}
+
+	typeName := types.ExprString(rcvType)
+
+	if len(field.Names) > 0 && field.Names[0].Name != "_" {
+		if err := rv.validateName(errPrefix, typeName, field.Names[0].Name, v.Name.Name); err != nil {
+			return err
+		}
+	}
+
+	if !rv.Kinds {
+		return nil
+	}
+
+	for _, e := range rv.Exceptions {
+		if e == v.Name.Name {
+			return nil
+		}
+	}
+
+	expected, ok := rv.pointers[typeName]
+	if !ok {
+		rv.pointers[typeName] = pointer
+		return nil
+	}
+
+	if expected != pointer {
+		kind := "value"
+		if expected {
+			kind = "pointer"
+		}
+
+		return errors.Wrap(errors.Errorf("Method `%s` must use a %s receiver, as other `%s` methods", v.Name.Name, kind, typeName), errPrefix)
+	}
+
+	return nil
+}
+
+func (rv *ReceiversValidator) validateName(errPrefix, typeName, name, method string) error {
+	if rv.MaxLength > 0 && len(name) > rv.MaxLength {
+		return errors.Wrap(errors.Errorf("Receiver `%s` of method `%s` is longer than %d characters", name, method, rv.MaxLength), errPrefix)
+	}
+
+	if !rv.Names {
+		return nil
+	}
+
+	if name == "this" || name == "self" {
+		return errors.Wrap(errors.Errorf("Receiver `%s` of method `%s` is not allowed", name, method), errPrefix)
+	}
+
+	expected, ok := rv.names[typeName]
+	if !ok {
+		rv.names[typeName] = name
+		return nil
+	}
+
+	if expected != name {
+		return errors.Wrap(errors.Errorf("Receiver `%s` of method `%s` must be named `%s`, as other `%s` methods", name, method, expected, typeName), errPrefix)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package nit_test
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestReceiversValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		validator     nit.ReceiversValidator
+		expectedError bool
+	}{
+		{
+			"OK",
+			"receivers_valid.go",
+			nit.ReceiversValidator{Kinds: true, MaxLength: 1, Names: true},
+			false,
+		},
+		{
+			"OK: names disabled",
+			"receivers_names.go",
+			nit.ReceiversValidator{Kinds: true},
+			false,
+		},
+		{
+			"OK: kinds exceptions",
+			"receivers_kinds.go",
+			nit.ReceiversValidator{Exceptions: []string{"String"}, Kinds: true},
+			false,
+		},
+		{
+			"Error: names",
+			"receivers_names.go",
+			nit.ReceiversValidator{Names: true},
+			true,
+		},
+		{
+			"Error: self",
+			"receivers_self.go",
+			nit.ReceiversValidator{Names: true},
+			true,
+		},
+		{
+			"Error: max length",
+			"receivers_self.go",
+			nit.ReceiversValidator{MaxLength: 2},
+			true,
+		},
+		{
+			"Error: kinds",
+			"receivers_kinds.go",
+			nit.ReceiversValidator{Kinds: true},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			f, fset := newParserFile(ts, tt.filename)
+
+			validator := tt.validator
+
+			var err error
+
+			for _, s := range f.Decls {
+				if g, ok := s.(*ast.FuncDecl); ok {
+					if err = validator.Validate(g, fset); err != nil {
+						break
+					}
+				}
+			}
+
+			if tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package nit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type (
+	// Rule represents one of the rules validated by nit, Messages are the
+	// regular expressions matching the errors reported by the rule.
+	Rule struct {
+		ID          string   `json:"id"`
+		Description string   `json:"description"`
+		Rationale   string   `json:"rationale"`
+		Good        string   `json:"good"`
+		Bad         string   `json:"bad"`
+		Options     []string `json:"options,omitempty"`
+		Messages    []string `json:"messages"`
+	}
+)
+
+// FindRule returns the rule matching the received value, either its ID or one
+// of the errors reported by it.
+func FindRule(s string) (Rule, bool) {
+	rules := Rules()
+
+	for _, r := range rules {
+		if r.ID == s {
+			return r, true
+		}
+	}
+
+	for _, r := range rules {
+		for _, m := range r.Messages {
+			if regexp.MustCompile(m).MatchString(s) {
+				return r, true
+			}
+		}
+	}
+
+	return Rule{}, false
+}
+
+// Rules returns the catalogue of rules, when an error matches the messages of
+// multiple rules the first one is the one reporting it.
+func Rules() []Rule { //nolint: funlen
+	return []Rule{
+		{
+			ID:          "file-sections",
+			Description: "Sections are declared in order: imports, types, consts, vars, funcs and methods.",
+			Rationale:   "Every file reads the same way top to bottom, dependencies are declared before the code using them.",
+			Good:        "import (\n\t\"fmt\"\n)\n\nconst (\n\tName = \"nit\"\n)\n\nfunc Print() { fmt.Println(Name) }",
+			Bad:         "import (\n\t\"fmt\"\n)\n\nfunc Print() { fmt.Println(Name) }\n\nconst (\n\tName = \"nit\"\n)",
+			Messages:    []string{"`[a-z]+` is invalid, next one must be "},
+		},
+		{
+			ID:          "parenthesized-declarations",
+			Description: "imports, types, consts and vars declarations are parenthesized.",
+			Rationale:   "Parenthesized declarations group related names and keep diffs small when adding more names.",
+			Good:        "var (\n\tx = 1\n)",
+			Bad:         "var x = 1",
+			Options:     []string{"-parentheses", "-fix-parentheses"},
+			Messages:    []string{"expected parenthesized declaration"},
+		},
+		{
+			ID:          "section-blocks",
+			Description: "The number of blocks per section is limited, by default one types block and one vars block.",
+			Rationale:   "Names declared in one block are easier to find and their order is validated as a whole.",
+			Good:        "type (\n\tA struct{}\n\tB struct{}\n)",
+			Bad:         "type (\n\tA struct{}\n)\n\ntype (\n\tB struct{}\n)",
+			Options:     []string{"-blocks"},
+			Messages:    []string{"only one `[a-z]+` section block is allowed per file"},
+		},
+		{
+			ID:          "imports-groups",
+			Description: "Imports are separated by a blank line in groups, by default: standard, external and local packages.",
+			Rationale:   "Grouped imports make clear where each dependency comes from.",
+			Good:        "import (\n\t\"fmt\"\n\n\t\"github.com/pkg/errors\"\n)",
+			Bad:         "import (\n\t\"github.com/pkg/errors\"\n\t\"fmt\"\n)",
+			Options:     []string{"-pkg", "-imports-groups"},
+			Messages: []string{
+				" imports is invalid, next one must be ",
+				"missing line break in section",
+				"extra line break in section",
+				"import `[^`]+` does not match any group",
+			},
+		},
+		{
+			ID:          "imports-sorted",
+			Description: "Imports in each group are sorted by path, and each path is imported once.",
+			Rationale:   "Sorted imports are easier to scan and produce predictable diffs.",
+			Good:        "import (\n\t\"fmt\"\n\t\"strings\"\n)",
+			Bad:         "import (\n\t\"strings\"\n\t\"fmt\"\n)",
+			Messages:    []string{"import `[^`]+` is not sorted", "import `[^`]+` is duplicated"},
+		},
+		{
+			ID:          "imports-aliases",
+			Description: "Imports aliases follow conventions: no unnecessary aliases, required for versioned paths, no dot imports outside tests, and configured aliases.",
+			Rationale:   "Consistent aliases make the same package read the same way in every file.",
+			Good:        "import (\n\tmetav1 \"k8s.io/apimachinery/pkg/apis/meta/v1\"\n)",
+			Bad:         "import (\n\tv1 \"k8s.io/apimachinery/pkg/apis/meta/v1\"\n)",
+			Options:     []string{"-imports-aliases", "-imports-alias"},
+			Messages: []string{
+				"import `[^`]+` must use the alias ",
+				"dot import `[^`]+` is not allowed",
+				"import `[^`]+` requires an alias",
+				"alias `[^`]+` for import `[^`]+` is unnecessary",
+			},
+		},
+		{
+			ID:          "test-funcs",
+			Description: "Test files declare Test functions first, then Benchmark, Fuzz, Example and finally helpers.",
+			Rationale:   "Test files read the same way, the tests first and the code supporting them last.",
+			Good:        "func TestA(t *testing.T) {}\n\nfunc BenchmarkA(b *testing.B) {}",
+			Bad:         "func BenchmarkA(b *testing.B) {}\n\nfunc TestA(t *testing.T) {}",
+			Options:     []string{"-test-profile", "-sorted-tests"},
+			Messages:    []string{"(Test|Benchmark|Fuzz|Example|Helper) `[^`]+` is not (grouped correctly|sorted$)"},
+		},
+		{
+			ID:          "names-grouped",
+			Description: "Names in types, consts, vars, funcs and methods are grouped: exported first, then unexported.",
+			Rationale:   "The exported API of the file is found first.",
+			Good:        "var (\n\tExported = 1\n\tunexported = 2\n)",
+			Bad:         "var (\n\tunexported = 2\n\tExported = 1\n)",
+			Options:     []string{"-visibility"},
+			Messages:    []string{"(Type|Const|Var|Function|Method) `[^`]+` is not grouped correctly"},
+		},
+		{
+			ID:          "names-sorted",
+			Description: "Names in types, consts, vars, funcs and methods are sorted in each group, `//-` comments start new groups.",
+			Rationale:   "Sorted names are easy to find and reduce merge conflicts.",
+			Good:        "func A() {}\n\nfunc B() {}",
+			Bad:         "func B() {}\n\nfunc A() {}",
+			Options:     []string{"-collation", "-dependency-order"},
+			Messages:    []string{"(Type|Const|Var|Function|Method) `[^`]+` is not sorted$"},
+		},
+		{
+			ID:          "declaration-order",
+			Description: "consts and vars are not used for initializing the names declared before them in the same block.",
+			Rationale:   "Initialization reads top to bottom, even if Go allows any order.",
+			Good:        "var (\n\tb = 2\n\ta = b + 1\n)",
+			Bad:         "var (\n\ta = b + 1\n\tb = 2\n)",
+			Options:     []string{"-declaration-order"},
+			Messages:    []string{"`[^`]+` is used before its declaration"},
+		},
+		{
+			ID:          "enums",
+			Description: "Typed iota enums are declared before other const blocks, following the order of their types.",
+			Rationale:   "Enums are read right after the types defining them.",
+			Good:        "type (\n\tColor uint8\n)\n\nconst (\n\tColorRed Color = iota\n\tColorBlue\n)",
+			Bad:         "const (\n\tName = \"nit\"\n)\n\nconst (\n\tColorRed Color = iota\n\tColorBlue\n)",
+			Options:     []string{"-enums"},
+			Messages:    []string{"Enum `[^`]+` must be declared before other `const` blocks", "Enum `[^`]+` must follow the types declaration order"},
+		},
+		{
+			ID:          "interfaces",
+			Description: "Interface methods are sorted: embedded interfaces first, then exported and unexported methods.",
+			Rationale:   "Interfaces read like the methods section of a type.",
+			Good:        "type (\n\tReadCloser interface {\n\t\tio.Reader\n\t\tClose() error\n\t}\n)",
+			Bad:         "type (\n\tReadCloser interface {\n\t\tClose() error\n\t\tio.Reader\n\t}\n)",
+			Options:     []string{"-sorted-interfaces", "-fix-interfaces"},
+			Messages:    []string{"Embedded `[^`]+` must be declared before methods", "Interface method `[^`]+` is not (grouped correctly|sorted$)"},
+		},
+		{
+			ID:          "struct-fields",
+			Description: "Struct fields are grouped: embedded first, then exported and unexported fields.",
+			Rationale:   "The fields composing the type are found first, followed by its exported state.",
+			Good:        "type (\n\tServer struct {\n\t\tsync.Mutex\n\t\tAddr string\n\t\tconns int\n\t}\n)",
+			Bad:         "type (\n\tServer struct {\n\t\tconns int\n\t\tAddr string\n\t\tsync.Mutex\n\t}\n)",
+			Options:     []string{"-struct-fields", "-sorted-struct-fields"},
+			Messages:    []string{"Embedded `[^`]+` must be declared before fields", "Field `[^`]+` is not (grouped correctly|sorted$)"},
+		},
+		{
+			ID:          "constructors-first",
+			Description: "Constructors are declared before other functions, following the order of the types they construct.",
+			Rationale:   "The way to create a type is found right after the types section.",
+			Good:        "func NewServer() *Server {}\n\nfunc Listen() {}",
+			Bad:         "func Listen() {}\n\nfunc NewServer() *Server {}",
+			Options:     []string{"-constructors-first"},
+			Messages: []string{
+				"`[^`]+` must be declared before other functions",
+				"`[^`]+` is not sorted by type",
+				"Constructor `[^`]+` is not (grouped correctly|sorted$)",
+			},
+		},
+		{
+			ID:          "methods-types",
+			Description: "Methods are declared for types in the file, grouped by type.",
+			Rationale:   "Methods are found next to the other methods of their type.",
+			Good:        "func (s *Server) Close() {}\n\nfunc (s *Server) Listen() {}",
+			Bad:         "func (s *Server) Listen() {}\n\nfunc (c *Client) Do() {}\n\nfunc (s *Server) Close() {}",
+			Options:     []string{"-methods-types-order"},
+			Messages:    []string{"Type `[^`]+` is not defined in the file", "`[^`]+` is not sorted as declared"},
+		},
+		{
+			ID:          "special-funcs",
+			Description: "Special functions are placed as configured: init and TestMain first, main first or last, and one init per file.",
+			Rationale:   "Functions called implicitly are found in a predictable place.",
+			Good:        "func init() {}\n\nfunc A() {}",
+			Bad:         "func A() {}\n\nfunc init() {}",
+			Options:     []string{"-init-first", "-single-init", "-main", "-testmain-first"},
+			Messages: []string{
+				"only one `init` function is allowed per file",
+				"Function `[^`]+` must be declared first",
+				"Function `[^`]+` must be declared before `",
+			},
+		},
+		{
+			ID:          "directives",
+			Description: "File-level directives are placed correctly: //go:build before the package clause, //go:generate before any declaration and //go:embed in the vars section.",
+			Rationale:   "Directives affecting the whole file are found at its top.",
+			Good:        "//go:build linux\n\npackage nit",
+			Bad:         "//go:build linux\npackage nit",
+			Options:     []string{"-directives", "-generate-file"},
+			Messages:    []string{"`//go:(build|embed|generate)`", "only one `//go:build` constraint is allowed per file"},
+		},
+		{
+			ID:          "receivers",
+			Description: "Methods receivers are consistent: same name per type, not this or self, short, and all pointers or all values.",
+			Rationale:   "Consistent receivers make methods of the same type read the same way.",
+			Good:        "func (s *Server) Close() {}\n\nfunc (s *Server) Listen() {}",
+			Bad:         "func (self *Server) Close() {}\n\nfunc (srv Server) Listen() {}",
+			Options:     []string{"-receiver-names", "-receiver-max-length", "-receiver-kinds", "-receiver-kinds-exceptions"},
+			Messages:    []string{"Receiver `[^`]+` of method ", "must use a (pointer|value) receiver"},
+		},
+		{
+			ID:          "package-types",
+			Description: "Exported types are placed in files following the package conventions: one primary type per file, named after it, or in designated files.",
+			Rationale:   "Types are found by file name.",
+			Good:        "// user.go\ntype (\n\tUser struct{}\n)",
+			Bad:         "// helpers.go\ntype (\n\tUser struct{}\n\tAccount struct{}\n)",
+			Options:     []string{"-primary-type", "-filename-type", "-types-files"},
+			Messages:    []string{"Type `[^`]+` must be declared in ", "File must be named after type "},
+		},
+		{
+			ID:          "limits",
+			Description: "Files are limited by number of lines, top-level declarations, types and methods per type.",
+			Rationale:   "Large files are hard to navigate, splitting them keeps each one focused.",
+			Good:        "// server.go\ntype (\n\tServer struct{}\n)\n\n// client.go\ntype (\n\tClient struct{}\n)",
+			Bad:         "// all.go\ntype (\n\tClient struct{}\n\tServer struct{}\n)",
+			Options:     []string{"-max-lines", "-max-decls", "-max-types", "-max-methods"},
+			Messages:    []string{"consider splitting it"},
+		},
+	}
+}
+
+//-
+
+// String returns the rule explanation, including its examples.
+func (r Rule) String() string {
+	var b strings.Builder
+
+	indent := func(code string) string {
+		lines := strings.Split(code, "\n")
+		for i, l := range lines {
+			if l != "" {
+				lines[i] = "\t" + l
+			}
+		}
+
+		return strings.Join(lines, "\n")
+	}
+
+	fmt.Fprintf(&b, "%s: %s\n\n%s\n\nBad:\n\n%s\n\nGood:\n\n%s\n", r.ID, r.Description, r.Rationale, indent(r.Bad), indent(r.Good))
+
+	if len(r.Options) > 0 {
+		fmt.Fprintf(&b, "\nOptions: %s\n", strings.Join(r.Options, ", "))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,271 @@
+package nit_test
+
+import (
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestFindRule(t *testing.T) {
+	tests := [...]struct {
+		name     string
+		input    string
+		expected string
+		found    bool
+	}{
+		{
+			"OK: id",
+			"names-sorted",
+			"names-sorted",
+			true,
+		},
+		{
+			"OK: imports groups",
+			"file.go:5:2: missing line break in section",
+			"imports-groups",
+			true,
+		},
+		{
+			"OK: file sections",
+			"file.go:9:1: `types` is invalid, next one must be `const`, `var`, `functions` or `methods`",
+			"file-sections",
+			true,
+		},
+		{
+			"OK: imports sorted",
+			"file.go:5:2: import `fmt` is not sorted",
+			"imports-sorted",
+			true,
+		},
+		{
+			"OK: names sorted",
+			"file.go:5:2: Type `A` is not sorted",
+			"names-sorted",
+			true,
+		},
+		{
+			"OK: constructors",
+			"file.go:5:2: Constructor `NewA` is not sorted by type",
+			"constructors-first",
+			true,
+		},
+		{
+			"OK: test funcs",
+			"file_test.go:5:1: Test `TestA` is not grouped correctly",
+			"test-funcs",
+			true,
+		},
+		{
+			"OK: names grouped",
+			"file.go:5:1: Function `A` is not grouped correctly",
+			"names-grouped",
+			true,
+		},
+		{
+			"OK: limits",
+			"file.go: file has 900 lines, more than 500, consider splitting it",
+			"limits",
+			true,
+		},
+		{
+			"Not found",
+			"unknown",
+			"",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, found := nit.FindRule(tt.input)
+			if found != tt.found {
+				ts.Fatalf("expected found %t, got %t", tt.found, found)
+			}
+
+			if actual.ID != tt.expected {
+				ts.Fatalf("expected %s, got %s", tt.expected, actual.ID)
+			}
+		})
+	}
+}
+
+func TestFindRule_Validators(t *testing.T) {
+	tests := [...]struct {
+		name      string
+		filename  string
+		nitpicker nit.Nitpicker
+		expected  string
+	}{
+		{
+			"OK: file sections",
+			"rules_file_sections.go",
+			nit.Nitpicker{},
+			"file-sections",
+		},
+		{
+			"OK: section blocks",
+			"nitpicker_error_type.go",
+			nit.Nitpicker{},
+			"section-blocks",
+		},
+		{
+			"OK: imports groups",
+			"imports_missing_line.go",
+			nit.Nitpicker{},
+			"imports-groups",
+		},
+		{
+			"OK: imports sorted",
+			"imports_sorted.go",
+			nit.Nitpicker{},
+			"imports-sorted",
+		},
+		{
+			"OK: names grouped",
+			"vars_group1.go",
+			nit.Nitpicker{},
+			"names-grouped",
+		},
+		{
+			"OK: names sorted",
+			"types_sorted.go",
+			nit.Nitpicker{},
+			"names-sorted",
+		},
+		{
+			"OK: methods sorted",
+			"methods_sorted_error.go",
+			nit.Nitpicker{},
+			"names-sorted",
+		},
+		{
+			"OK: declaration order",
+			"dependencies_declaration.go",
+			nit.Nitpicker{DeclarationOrder: true},
+			"declaration-order",
+		},
+		{
+			"OK: interfaces embedded",
+			"interfaces_embedded.go",
+			nit.Nitpicker{SortedInterfaces: true},
+			"interfaces",
+		},
+		{
+			"OK: interfaces grouped",
+			"interfaces_group.go",
+			nit.Nitpicker{SortedInterfaces: true},
+			"interfaces",
+		},
+		{
+			"OK: interfaces sorted",
+			"interfaces_sorted.go",
+			nit.Nitpicker{SortedInterfaces: true},
+			"interfaces",
+		},
+		{
+			"OK: struct fields grouped",
+			"structs_group.go",
+			nit.Nitpicker{StructFields: true},
+			"struct-fields",
+		},
+		{
+			"OK: struct fields sorted",
+			"structs_sorted.go",
+			nit.Nitpicker{StructFields: true, SortedStructFields: true},
+			"struct-fields",
+		},
+		{
+			"OK: constructors first",
+			"constructors_first.go",
+			nit.Nitpicker{ConstructorsFirst: true},
+			"constructors-first",
+		},
+		{
+			"OK: constructors sorted",
+			"constructors_sorted_name.go",
+			nit.Nitpicker{ConstructorsFirst: true},
+			"constructors-first",
+		},
+		{
+			"OK: methods types",
+			"methods_types_order_error.go",
+			nit.Nitpicker{MethodsTypesOrder: true},
+			"methods-types",
+		},
+		{
+			"OK: test funcs sorted",
+			"rules_test_funcs_test.go",
+			nit.Nitpicker{TestProfile: true, SortedTests: true},
+			"test-funcs",
+		},
+		{
+			"OK: special funcs",
+			"special_funcs_inits.go",
+			nit.Nitpicker{SingleInit: true},
+			"special-funcs",
+		},
+		{
+			"OK: enums",
+			"enums_first.go",
+			nit.Nitpicker{Enums: true},
+			"enums",
+		},
+		{
+			"OK: receivers",
+			"receivers_self.go",
+			nit.Nitpicker{ReceiverNames: true},
+			"receivers",
+		},
+		{
+			"OK: limits",
+			"limits.go",
+			nit.Nitpicker{MaxTypes: 1},
+			"limits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			n := tt.nitpicker
+			n.LocalPath = "github.com/MarioCarrion"
+
+			err := n.Validate(filepath.Join("testdata", tt.filename))
+			if err == nil {
+				ts.Fatalf("expected error, got nil")
+			}
+
+			actual, found := nit.FindRule(err.Error())
+			if !found {
+				ts.Fatalf("expected rule for %s", err)
+			}
+
+			if actual.ID != tt.expected {
+				ts.Fatalf("expected %s, got %s for %s", tt.expected, actual.ID, err)
+			}
+		})
+	}
+}
+
+func TestRules(t *testing.T) {
+	ids := make(map[string]struct{})
+
+	for _, r := range nit.Rules() {
+		if _, ok := ids[r.ID]; ok {
+			t.Fatalf("rule %s is duplicated", r.ID)
+		}
+
+		ids[r.ID] = struct{}{}
+
+		if r.Description == "" || r.Rationale == "" || r.Good == "" || r.Bad == "" || len(r.Messages) == 0 {
+			t.Fatalf("rule %s is incomplete", r.ID)
+		}
+
+		for _, m := range r.Messages {
+			if _, err := regexp.Compile(m); err != nil {
+				t.Fatalf("rule %s message is invalid: %s", r.ID, err)
+			}
+		}
+	}
+}
@@ -2,15 +2,20 @@ package nit
 
 import (
 	"go/ast"
+	"go/token"
 
 	"github.com/pkg/errors"
 )
 
 type (
 	sortedNamesValidator struct {
-		identType string
-		exported  *bool
-		last      string
+		identType    string
+		first        *bool
+		last         string
+		collation    Collation
+		visibility   VisibilityPolicy
+		dependencies bool
+		declarations bool
 	}
 )
 
@@ -28,13 +33,34 @@ func (v *sortedNamesValidator) validateName(errPrefix string, name *ast.Ident) e
 
 //-
 
+// reset prepares the validator for a new block following the policy, when
+// sorting across blocks only the sorted names are reset.
+func (v *sortedNamesValidator) reset(p BlocksPolicy) {
+	v.last = ""
+
+	if p != BlocksPolicyManySorted {
+		v.first = nil
+	}
+}
+
 func (v *sortedNamesValidator) validateExported(errPrefix string, name *ast.Ident) error {
-	if v.exported == nil || (*v.exported && !name.IsExported()) {
-		e := name.IsExported()
-		v.exported = &e
+	if v.visibility == VisibilityPolicyIgnore {
+		return nil
 	}
 
-	if *v.exported != name.IsExported() {
+	first := name.IsExported() != (v.visibility == VisibilityPolicyUnexportedFirst)
+
+	if v.first == nil || (*v.first && !first) {
+		// Bytewise exported names sort before unexported ones, except non-ASCII
+		// ones, which are kept sorted across groups by default.
+		if v.first != nil && (v.collation != CollationBytewise || v.visibility != VisibilityPolicyExportedFirst) {
+			v.last = ""
+		}
+
+		v.first = &first
+	}
+
+	if *v.first != first {
 		return errors.Wrap(errors.Errorf("%s `%s` is not grouped correctly", v.identType, name.Name), errPrefix)
 	}
 
@@ -42,7 +68,7 @@ func (v *sortedNamesValidator) validateExported(errPrefix string, name *ast.Iden
 }
 
 func (v *sortedNamesValidator) validateSortedName(errPrefix string, name *ast.Ident) error {
-	if v.last != "" && v.last > name.Name {
+	if v.last != "" && v.collation.Less(name.Name, v.last) {
 		return errors.Wrap(errors.Errorf("%s `%s` is not sorted", v.identType, name.Name), errPrefix)
 	}
 
@@ -50,3 +76,43 @@ func (v *sortedNamesValidator) validateSortedName(errPrefix string, name *ast.Id
 
 	return nil
 }
+
+// validateValueSpec validates the names declared in the spec, the index of
+// the spec in the block and the names declared in it are used for:
+// * When dependencies is set, names initialized using names declared before
+// them are only validated for grouping, and
+// * When declarations is set, using names declared after the spec fails.
+func (v *sortedNamesValidator) validateValueSpec(s *ast.ValueSpec, index int, declared map[*ast.Object]int, fset *token.FileSet) error {
+	var dependent bool
+
+	for _, ident := range usedNames(s.Values, declared) {
+		i := declared[ident.Obj]
+
+		if i > index && v.declarations {
+			return errors.Wrap(errors.Errorf("%s `%s` is used before its declaration", v.identType, ident.Name),
+				fset.PositionFor(ident.Pos(), false).String())
+		}
+
+		if i < index {
+			dependent = true
+		}
+	}
+
+	errPrefix := fset.PositionFor(s.Pos(), false).String()
+
+	for _, name := range s.Names {
+		if dependent && v.dependencies {
+			if err := v.validateExported(errPrefix, name); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := v.validateName(errPrefix, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// FuncPlacement represents where a special function, like `init` or
+	// `main`, is declared in the `func` section.
+	FuncPlacement uint8
+
+	// SpecialFuncsValidator defines the type including the rules used for
+	// validating the placement of special functions.
+	SpecialFuncsValidator struct {
+		// Placements defines the placement of the special functions, indexed
+		// by name, functions not included follow the regular rules.
+		Placements map[string]FuncPlacement
+		// SingleInit indicates at most one `init` function is allowed.
+		SingleInit bool
+		//-
+		funcs bool
+		inits int
+		last  string
+	}
+)
+
+const (
+	// FuncPlacementAny indicates the function follows the regular rules.
+	FuncPlacementAny FuncPlacement = iota
+
+	// FuncPlacementFirst indicates the function is declared before the
+	// regular functions.
+	FuncPlacementFirst
+
+	// FuncPlacementLast indicates the function is declared after the regular
+	// functions.
+	FuncPlacementLast
+)
+
+// NewFuncPlacement returns the value representing the received placement
+// name: "any", "first" or "last".
+func NewFuncPlacement(s string) (FuncPlacement, error) {
+	switch s {
+	case "", "any":
+		return FuncPlacementAny, nil
+	case "first":
+		return FuncPlacementFirst, nil
+	case "last":
+		return FuncPlacementLast, nil
+	}
+
+	return FuncPlacementAny, errors.Errorf("invalid function placement value: %s", s)
+}
+
+// IsSpecial indicates whether the function has a configured placement.
+func (sv *SpecialFuncsValidator) IsSpecial(v *ast.FuncDecl) bool {
+	return v.Recv == nil && sv.Placements[v.Name.Name] != FuncPlacementAny
+}
+
+// Validate makes sure the implemented function satisfies the following rules
+// considering all previous declared functions:
+// * Special functions placed first are declared before regular functions,
+// * Special functions placed last are declared after regular functions, and
+// * When SingleInit is set, at most one `init` function is declared.
+func (sv *SpecialFuncsValidator) Validate(v *ast.FuncDecl, fset *token.FileSet) error {
+	if v.Recv != nil {
+		return nil
+	}
+
+	errPrefix := fset.PositionFor(v.Pos(), false).String()
+
+	if v.Name.Name == "init" {
+		sv.inits++
+
+		if sv.SingleInit && sv.inits > 1 {
+			return errors.Wrap(errors.New("only one `init` function is allowed per file"), errPrefix)
+		}
+	}
+
+	switch sv.Placements[v.Name.Name] {
+	case FuncPlacementFirst:
+		if sv.funcs || sv.last != "" {
+			return errors.Wrap(errors.Errorf("Function `%s` must be declared first", v.Name.Name), errPrefix)
+		}
+	case FuncPlacementLast:
+		sv.last = v.Name.Name
+	default:
+		if sv.last != "" {
+			return errors.Wrap(errors.Errorf("Function `%s` must be declared before `%s`", v.Name.Name, sv.last), errPrefix)
+		}
+
+		sv.funcs = true
+	}
+
+	return nil
+}
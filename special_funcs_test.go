@@ -0,0 +1,130 @@
+package nit_test
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestNewFuncPlacement(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		input         string
+		expected      nit.FuncPlacement
+		expectedError bool
+	}{
+		{
+			"FuncPlacementAny",
+			"any",
+			nit.FuncPlacementAny,
+			false,
+		},
+		{
+			"FuncPlacementFirst",
+			"first",
+			nit.FuncPlacementFirst,
+			false,
+		},
+		{
+			"FuncPlacementLast",
+			"last",
+			nit.FuncPlacementLast,
+			false,
+		},
+		{
+			"Error",
+			"middle",
+			nit.FuncPlacementAny,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, err := nit.NewFuncPlacement(tt.input)
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+
+			if actual != tt.expected {
+				ts.Fatalf("expected %+v, actual %+v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+//-
+
+func TestSpecialFuncsValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		validator     nit.SpecialFuncsValidator
+		expectedError bool
+	}{
+		{
+			"OK",
+			"special_funcs_valid.go",
+			nit.SpecialFuncsValidator{
+				Placements: map[string]nit.FuncPlacement{"init": nit.FuncPlacementFirst, "main": nit.FuncPlacementLast},
+				SingleInit: true,
+			},
+			false,
+		},
+		{
+			"OK: any",
+			"special_funcs_init.go",
+			nit.SpecialFuncsValidator{},
+			false,
+		},
+		{
+			"OK: multiple init",
+			"special_funcs_inits.go",
+			nit.SpecialFuncsValidator{},
+			false,
+		},
+		{
+			"Error: init first",
+			"special_funcs_init.go",
+			nit.SpecialFuncsValidator{
+				Placements: map[string]nit.FuncPlacement{"init": nit.FuncPlacementFirst},
+			},
+			true,
+		},
+		{
+			"Error: single init",
+			"special_funcs_inits.go",
+			nit.SpecialFuncsValidator{SingleInit: true},
+			true,
+		},
+		{
+			"Error: main last",
+			"special_funcs_main.go",
+			nit.SpecialFuncsValidator{
+				Placements: map[string]nit.FuncPlacement{"main": nit.FuncPlacementLast},
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			var err error
+
+			f, fset := newParserFile(ts, tt.filename)
+
+			validator := tt.validator
+
+			for _, s := range f.Decls {
+				if g, ok := s.(*ast.FuncDecl); ok && err == nil {
+					err = validator.Validate(g, fset)
+				}
+			}
+
+			if tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
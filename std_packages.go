@@ -0,0 +1,68 @@
+package nit
+
+import (
+	"bufio"
+	"bytes"
+	"go/build"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+//nolint: gochecknoglobals
+var (
+	stdPackages      = make(map[string]map[string]struct{})
+	stdPackagesMutex sync.Mutex
+)
+
+// IsStandardPackage indicates whether the import path belongs to the Go
+// standard library, it uses the packages listed by the Go toolchain of the
+// current GOROOT, when those are not available paths without dots are
+// considered standard.
+func IsStandardPackage(path string) bool {
+	if path == "C" { // cgo pseudo package
+		return true
+	}
+
+	pkgs := standardPackages(build.Default.GOROOT)
+	if pkgs == nil {
+		return !strings.Contains(path, ".")
+	}
+
+	_, ok := pkgs[path]
+
+	return ok
+}
+
+func standardPackages(goroot string) map[string]struct{} {
+	stdPackagesMutex.Lock()
+	defer stdPackagesMutex.Unlock()
+
+	if pkgs, ok := stdPackages[goroot]; ok {
+		return pkgs
+	}
+
+	var pkgs map[string]struct{}
+
+	if goroot != "" {
+		cmd := exec.Command(filepath.Join(goroot, "bin", "go"), "list", "std") //nolint: gosec
+		cmd.Env = append(os.Environ(), "GOROOT="+goroot)
+
+		if out, err := cmd.Output(); err == nil {
+			pkgs = make(map[string]struct{})
+
+			s := bufio.NewScanner(bytes.NewReader(out))
+			for s.Scan() {
+				if pkg := s.Text(); !strings.HasPrefix(pkg, "vendor/") {
+					pkgs[pkg] = struct{}{}
+				}
+			}
+		}
+	}
+
+	stdPackages[goroot] = pkgs // cached even when nil to avoid calling the toolchain again
+
+	return pkgs
+}
@@ -0,0 +1,54 @@
+package nit_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestIsStandardPackage(t *testing.T) {
+	tests := [...]struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			"OK: fmt",
+			"fmt",
+			true,
+		},
+		{
+			"OK: net/http",
+			"net/http",
+			true,
+		},
+		{
+			"OK: cgo",
+			"C",
+			true,
+		},
+		{
+			"OK: no dots",
+			"mycorp/lib",
+			false,
+		},
+		{
+			"OK: external",
+			"github.com/MarioCarrion/nit",
+			false,
+		},
+		{
+			"OK: vendored",
+			"golang.org/x/net/http/httpguts",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			if actual := nit.IsStandardPackage(tt.input); actual != tt.expected {
+				ts.Fatalf("expected %t, actual %t", tt.expected, actual)
+			}
+		})
+	}
+}
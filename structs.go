@@ -0,0 +1,108 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// StructsValidator defines the type including the rules used for
+	// validating the fields declared in struct types.
+	StructsValidator struct {
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		// Sorted indicates the fields in each group must be sorted as well,
+		// disabled by default because fields order affects memory layout.
+		Sorted bool
+		//-
+		comments *BreakComments
+	}
+)
+
+// NewStructsValidator returns a correctly initialized StructsValidator.
+func NewStructsValidator(c *BreakComments) *StructsValidator {
+	return &StructsValidator{comments: c}
+}
+
+func anonymousStruct(e ast.Expr) *ast.StructType {
+	for {
+		switch t := e.(type) {
+		case *ast.StructType:
+			return t
+		case *ast.StarExpr:
+			e = t.X
+		case *ast.ArrayType:
+			e = t.Elt
+		case *ast.MapType:
+			e = t.Value
+		case *ast.ChanType:
+			e = t.Value
+		default:
+			return nil
+		}
+	}
+}
+
+//-
+
+// Validate makes sure the implemented struct type, and its nested anonymous
+// structs, satisfy the following rules:
+// * Embedded fields are declared first,
+// * Exported fields are declared next,
+// * Unexported fields are declared last, and
+// * When Sorted is set, both groups can declare their own sorted subgroups.
+func (sv *StructsValidator) Validate(v *ast.StructType, fset *token.FileSet) error {
+	if v.Fields == nil {
+		return nil
+	}
+
+	var (
+		fields  bool
+		lastEnd int
+		sorted  = sortedNamesValidator{identType: "Field", collation: sv.Collation}
+	)
+
+	for _, f := range v.Fields.List {
+		errPrefix := fset.PositionFor(f.Pos(), false).String()
+		line := fset.PositionFor(f.Pos(), false).Line
+
+		if len(f.Names) == 0 {
+			if fields {
+				return errors.Wrap(errors.Errorf("Embedded `%s` must be declared before fields", types.ExprString(f.Type)), errPrefix)
+			}
+		} else {
+			fields = true
+
+			if len(sv.comments.Nested(lastEnd+1, line-1)) > 0 {
+				sorted.last = ""
+			}
+
+			for _, name := range f.Names {
+				if err := sorted.validateExported(errPrefix, name); err != nil {
+					return err
+				}
+
+				if !sv.Sorted {
+					continue
+				}
+
+				if err := sorted.validateSortedName(errPrefix, name); err != nil {
+					return err
+				}
+			}
+		}
+
+		if nested := anonymousStruct(f.Type); nested != nil {
+			if err := sv.Validate(nested, fset); err != nil {
+				return err
+			}
+		}
+
+		lastEnd = fset.PositionFor(f.End(), false).Line
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package nit_test
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+//nolint:dupl
+func TestStructsValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		sorted        bool
+		expectedError bool
+	}{
+		{
+			"OK",
+			"structs_valid.go",
+			true,
+			false,
+		},
+		{
+			"OK: not sorted",
+			"structs_sorted.go",
+			false,
+			false,
+		},
+		{
+			"Error: embedded",
+			"structs_embedded.go",
+			false,
+			true,
+		},
+		{
+			"Error: grouped",
+			"structs_group.go",
+			false,
+			true,
+		},
+		{
+			"Error: nested",
+			"structs_nested.go",
+			false,
+			true,
+		},
+		{
+			"Error: sorted",
+			"structs_sorted.go",
+			true,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			var err error
+
+			f, fset := newParserFile(ts, tt.filename)
+
+			validator := nit.NewStructsValidator(nit.NewBreakComments(fset, f.Comments))
+			validator.Sorted = tt.sorted
+
+			for _, s := range f.Decls {
+				g, ok := s.(*ast.GenDecl)
+				if !ok || g.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range g.Specs {
+					if st, ok := spec.(*ast.TypeSpec).Type.(*ast.StructType); ok && err == nil {
+						err = validator.Validate(st, fset)
+					}
+				}
+			}
+
+			if tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
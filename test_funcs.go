@@ -0,0 +1,127 @@
+package nit
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// TestFuncsValidator defines the type including the rules used for
+	// validating functions in test files.
+	TestFuncsValidator struct {
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		// Sorted indicates the functions of each kind must be sorted.
+		Sorted bool
+		//-
+		comments *BreakComments
+		sorted   sortedNamesValidator
+		kind     testFuncKind
+	}
+
+	testFuncKind uint8
+)
+
+const (
+	testFuncKindTest testFuncKind = iota
+	testFuncKindBenchmark
+	testFuncKindFuzz
+	testFuncKindExample
+	testFuncKindHelper
+)
+
+// NewTestFuncsValidator returns a correctly initialized TestFuncsValidator.
+func NewTestFuncsValidator(c *BreakComments) *TestFuncsValidator {
+	return &TestFuncsValidator{comments: c}
+}
+
+func newTestFuncKind(name string) testFuncKind {
+	for _, k := range [...]testFuncKind{testFuncKindTest, testFuncKindBenchmark, testFuncKindFuzz, testFuncKindExample} {
+		prefix := k.String()
+
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		if len(name) == len(prefix) {
+			return k
+		}
+
+		if r, _ := utf8.DecodeRuneInString(name[len(prefix):]); !unicode.IsLower(r) {
+			return k
+		}
+	}
+
+	return testFuncKindHelper
+}
+
+//-
+
+// IsHelper indicates whether the function is not a Test, Benchmark, Fuzz or
+// Example function.
+func (tv *TestFuncsValidator) IsHelper(v *ast.FuncDecl) bool {
+	return newTestFuncKind(v.Name.Name) == testFuncKindHelper
+}
+
+// Validate makes sure the implemented function satisfies the following rules
+// considering all previous declared functions:
+// * Test functions are declared first,
+// * Benchmark, Fuzz and Example functions are declared next, in that order,
+// * Helper functions are declared last, and
+// * When Sorted is set, Test, Benchmark, Fuzz and Example functions are
+// sorted, each kind can declare its own sorted subgroups.
+func (tv *TestFuncsValidator) Validate(v *ast.FuncDecl, fset *token.FileSet) error {
+	errPrefix := fset.PositionFor(v.Pos(), false).String()
+
+	kind := newTestFuncKind(v.Name.Name)
+	if kind < tv.kind {
+		return errors.Wrap(errors.Errorf("%s `%s` is not grouped correctly", kind, v.Name.Name), errPrefix)
+	}
+
+	if kind != tv.kind {
+		tv.sorted = sortedNamesValidator{}
+		tv.kind = kind
+	}
+
+	if kind == testFuncKindHelper || !tv.Sorted {
+		return nil
+	}
+
+	tv.sorted.identType = kind.String()
+	tv.sorted.collation = tv.Collation
+
+	next := tv.comments.Next()
+	if next != -1 && fset.PositionFor(v.Pos(), false).Line > next {
+		tv.sorted.last = ""
+	}
+
+	if err := tv.sorted.validateSortedName(errPrefix, v.Name); err != nil {
+		return err
+	}
+
+	tv.comments.MoveTo(fset.PositionFor(v.End(), false).Line)
+
+	return nil
+}
+
+//-
+
+func (k testFuncKind) String() string {
+	switch k {
+	case testFuncKindTest:
+		return "Test"
+	case testFuncKindBenchmark:
+		return "Benchmark"
+	case testFuncKindFuzz:
+		return "Fuzz"
+	case testFuncKindExample:
+		return "Example"
+	}
+
+	return "Helper"
+}
@@ -0,0 +1,69 @@
+package nit_test
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestTestFuncsValidator_Validate(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		sorted        bool
+		expectedError bool
+	}{
+		{
+			"OK",
+			"test_funcs_valid.go",
+			true,
+			false,
+		},
+		{
+			"OK: not sorted",
+			"test_funcs_sorted.go",
+			false,
+			false,
+		},
+		{
+			"Error: grouped",
+			"test_funcs_group.go",
+			false,
+			true,
+		},
+		{
+			"Error: helper",
+			"test_funcs_helper.go",
+			false,
+			true,
+		},
+		{
+			"Error: sorted",
+			"test_funcs_sorted.go",
+			true,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			var err error
+
+			f, fset := newParserFile(ts, tt.filename)
+
+			validator := nit.NewTestFuncsValidator(nit.NewBreakComments(fset, f.Comments))
+			validator.Sorted = tt.sorted
+
+			for _, s := range f.Decls {
+				if g, ok := s.(*ast.FuncDecl); ok && err == nil {
+					err = validator.Validate(g, fset)
+				}
+			}
+
+			if tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+import (
+	"os"
+)
+
+func BlocksImports() {
+	fmt.Println(os.Args)
+}
@@ -0,0 +1,14 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+import (
+	f "fmt"
+)
+
+func BlocksImportsDuplicated() {
+	fmt.Println("")
+	f.Println("")
+}
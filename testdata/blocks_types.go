@@ -0,0 +1,11 @@
+package testdata
+
+type (
+	BlocksTypesB int
+	blocksTypesA int
+)
+
+type (
+	BlocksTypesA int
+	blocksTypesB int
+)
@@ -0,0 +1,10 @@
+package testdata
+
+type (
+	BlocksTypesSortedB int
+)
+
+type (
+	BlocksTypesSortedA int
+	blocksTypesSorted  int
+)
@@ -0,0 +1,9 @@
+package testdata
+
+var (
+	BlocksVarsA = 1
+)
+
+var (
+	BlocksVarsB = 2
+)
@@ -0,0 +1,19 @@
+package testdata
+
+type BreakCommentInterface interface {
+	B()
+	//- NESTED
+	A()
+}
+
+type BreakCommentStruct struct {
+	B int
+	//- NESTED
+	A int
+}
+
+//- DETECTED
+
+func BreakComment4() {
+	//- NESTED
+}
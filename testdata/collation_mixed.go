@@ -0,0 +1,6 @@
+package testdata
+
+const (
+	CollationZeta  = 1
+	collationAlpha = 2
+)
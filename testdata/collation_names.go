@@ -0,0 +1,11 @@
+package testdata
+
+type (
+	CollationHandler    struct{}
+	CollationHTTPServer struct{}
+)
+
+const (
+	CollationItem2  = 2
+	CollationItem10 = 10
+)
@@ -0,0 +1,9 @@
+package testdata
+
+type (
+	ConstructorsFirst struct{}
+)
+
+func ConstructorsFirstHelper() {}
+
+func NewConstructorsFirst() *ConstructorsFirst { return &ConstructorsFirst{} }
@@ -0,0 +1,9 @@
+package testdata
+
+type (
+	ConstructorsSortedA struct{}
+	ConstructorsSortedB struct{}
+)
+
+func NewConstructorsSortedB() *ConstructorsSortedB { return &ConstructorsSortedB{} }
+func NewConstructorsSortedA() *ConstructorsSortedA { return &ConstructorsSortedA{} }
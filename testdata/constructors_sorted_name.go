@@ -0,0 +1,8 @@
+package testdata
+
+type (
+	ConstructorsSortedName struct{}
+)
+
+func NewConstructorsSortedNameY() *ConstructorsSortedName { return &ConstructorsSortedName{} }
+func NewConstructorsSortedNameX() *ConstructorsSortedName { return &ConstructorsSortedName{} }
@@ -0,0 +1,16 @@
+package testdata
+
+type (
+	ConstructorsValidB struct{}
+
+	//-
+
+	ConstructorsValidA struct{}
+)
+
+func NewConstructorsValidB() ConstructorsValidB { return ConstructorsValidB{} }
+
+func NewConstructorsValidA() *ConstructorsValidA      { return &ConstructorsValidA{} }
+func NewConstructorsValidAFromB() *ConstructorsValidA { return &ConstructorsValidA{} }
+func ConstructorsValidHelper()                        {}
+func constructorsValidHelper() bool                   { return true }
@@ -0,0 +1,8 @@
+package testdata
+
+const (
+	ConstsIotaMixedA = iota
+	ConstsIotaMixedB
+	ConstsIotaMixedZ = "z"
+	ConstsIotaMixedY = "y"
+)
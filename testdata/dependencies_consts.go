@@ -0,0 +1,7 @@
+package testdata
+
+const (
+	DependenciesB = 2
+	DependenciesA = DependenciesB * 2
+	dependenciesC = "c"
+)
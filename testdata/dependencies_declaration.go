@@ -0,0 +1,12 @@
+package testdata
+
+type (
+	dependenciesType struct {
+		value int
+	}
+)
+
+var (
+	dependenciesDeclarationA = dependenciesType{value: dependenciesDeclarationB}
+	dependenciesDeclarationB = 2
+)
@@ -0,0 +1,6 @@
+package testdata
+
+var (
+	dependenciesFuncLiteralA = func() int { return dependenciesFuncLiteralB }
+	dependenciesFuncLiteralB = 2
+)
@@ -0,0 +1,13 @@
+package testdata
+
+type (
+	dependenciesSelector struct {
+		value int
+	}
+)
+
+var (
+	dependenciesSelectorA = dependenciesSelector{value: 1}
+	dependenciesSelectorB = dependenciesSelectorA.value
+	value                 = 2
+)
@@ -0,0 +1,6 @@
+package testdata
+
+var (
+	dependenciesShadowedA = func(dependenciesShadowedB int) int { return dependenciesShadowedB }
+	dependenciesShadowedB = 2
+)
@@ -0,0 +1,7 @@
+package testdata
+
+var (
+	dependenciesB = 2
+	dependenciesA = dependenciesB + 1
+	dependenciesC = 3
+)
@@ -0,0 +1,3 @@
+//go:build linux
+// Package testdata includes the files used for testing.
+package testdata
@@ -0,0 +1,3 @@
+package testdata
+
+//go:build linux
@@ -0,0 +1,8 @@
+package testdata
+
+import (
+	_ "embed"
+)
+
+//go:embed directives_embed.go
+var directivesEmbed string
@@ -0,0 +1,11 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+//go:generate stringer -type=DirectivesGenerate
+
+func DirectivesGenerate() {
+	fmt.Println("")
+}
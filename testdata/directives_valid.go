@@ -0,0 +1,18 @@
+// Copyright notice.
+
+//go:build linux && amd64
+// +build linux,amd64
+
+// Package testdata includes the files used for testing.
+package testdata
+
+//go:generate stringer -type=DirectivesValid
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed directives_valid.go
+	directivesValid string
+)
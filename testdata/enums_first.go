@@ -0,0 +1,13 @@
+package testdata
+
+type (
+	EnumsFirst uint8
+)
+
+const (
+	EnumsFirstConst = "const"
+)
+
+const (
+	EnumsFirstA EnumsFirst = iota
+)
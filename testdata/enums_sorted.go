@@ -0,0 +1,14 @@
+package testdata
+
+type (
+	EnumsSortedA uint8
+	EnumsSortedB uint8
+)
+
+const (
+	EnumsSortedB1 EnumsSortedB = iota
+)
+
+const (
+	EnumsSortedA1 EnumsSortedA = iota
+)
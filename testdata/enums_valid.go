@@ -0,0 +1,24 @@
+package testdata
+
+type (
+	EnumsValidB uint8
+
+	//-
+
+	EnumsValidA uint8
+)
+
+const (
+	EnumsValidB1 EnumsValidB = iota
+	EnumsValidB0
+)
+
+const (
+	EnumsValidA1 EnumsValidA = iota + 1
+	EnumsValidA0
+)
+
+const (
+	EnumsValidConst = "const"
+	EnumsValidIota  = iota
+)
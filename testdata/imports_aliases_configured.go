@@ -0,0 +1,5 @@
+package testdata
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
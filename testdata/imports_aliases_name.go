@@ -0,0 +1,5 @@
+package testdata
+
+import (
+	"github.com/mattn/go-sqlite3"
+)
@@ -0,0 +1,5 @@
+package testdata
+
+import (
+	fmt "fmt"
+)
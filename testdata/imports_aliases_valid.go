@@ -0,0 +1,12 @@
+package testdata
+
+import (
+	"fmt"
+	mrand "math/rand"
+	_ "net/http/pprof"
+
+	chi "github.com/go-chi/chi/v5"
+	sqlite3 "github.com/mattn/go-sqlite3"
+	yaml "gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
@@ -0,0 +1,5 @@
+package testdata
+
+import (
+	"github.com/go-chi/chi/v5"
+)
@@ -0,0 +1,13 @@
+package testdata
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+func ImportsCgo() {
+	fmt.Println(unsafe.Sizeof(C.int(0)))
+}
@@ -0,0 +1,11 @@
+package testdata
+
+import (
+	"fmt"
+	f "fmt"
+)
+
+func ImportsDuplicated() {
+	fmt.Println("")
+	f.Println("")
+}
@@ -0,0 +1,13 @@
+package testdata
+
+import (
+	"fmt"
+
+	_ "embed"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func ImportsGroupsOrder() {
+	fmt.Printf("%+v", nit.Nitpicker{})
+}
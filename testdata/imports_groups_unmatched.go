@@ -0,0 +1,11 @@
+package testdata
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+func ImportsGroupsUnmatched() {
+	fmt.Printf("%s", errors.New(""))
+}
@@ -0,0 +1,17 @@
+package testdata
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/MarioCarrion/nit"
+
+	_ "embed"
+
+	. "strings"
+)
+
+func ImportsGroupsValid() {
+	fmt.Printf("%s%+v%s", errors.New(""), nit.Nitpicker{}, ToUpper(""))
+}
@@ -0,0 +1,10 @@
+package testdata
+
+import (
+	"os"
+	"fmt"
+)
+
+func ImportsSorted() {
+	fmt.Println(os.Args)
+}
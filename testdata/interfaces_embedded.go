@@ -0,0 +1,12 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+type (
+	InterfacesEmbedded interface {
+		Close() error
+		fmt.Stringer
+	}
+)
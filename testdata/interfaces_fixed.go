@@ -0,0 +1,21 @@
+package testdata
+
+import (
+	"io"
+)
+
+type (
+	// InterfacesUnsorted is fixed by FixInterfaces.
+	InterfacesUnsorted interface {
+		io.Reader
+		Open() error // Open opens.
+		// Write writes.
+		Write() error
+		close() error
+
+		//-
+
+		flush() error
+		seek() error
+	}
+)
@@ -0,0 +1,11 @@
+package testdata
+
+type (
+	InterfacesGroup interface {
+		close() error
+
+		//-
+
+		Open() error
+	}
+)
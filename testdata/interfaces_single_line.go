@@ -0,0 +1,5 @@
+package testdata
+
+type (
+	InterfacesSingleLine interface{ B(); A() }
+)
@@ -0,0 +1,8 @@
+package testdata
+
+type (
+	InterfacesSorted interface {
+		Open() error
+		Close() error //-
+	}
+)
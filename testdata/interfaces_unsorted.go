@@ -0,0 +1,21 @@
+package testdata
+
+import (
+	"io"
+)
+
+type (
+	// InterfacesUnsorted is fixed by FixInterfaces.
+	InterfacesUnsorted interface {
+		// Write writes.
+		Write() error
+		close() error
+		io.Reader
+		Open() error // Open opens.
+
+		//-
+
+		seek() error
+		flush() error
+	}
+)
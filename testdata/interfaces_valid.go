@@ -0,0 +1,23 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+type (
+	InterfacesValid interface {
+		fmt.Stringer
+		error
+
+		Close() error //- NOT-DETECTED
+		Open() error
+
+		//-
+
+		Flush() error
+		Reset()
+		flush()
+	}
+
+	InterfacesValidEmpty interface{}
+)
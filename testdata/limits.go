@@ -0,0 +1,24 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+type (
+	LimitsA struct{}
+	LimitsB struct{}
+)
+
+const (
+	LimitsConst = 1
+)
+
+func LimitsFunc() {
+	fmt.Println(LimitsConst)
+}
+
+func (LimitsA) A() {}
+
+func (LimitsA) B() {}
+
+func (LimitsB) A() {}
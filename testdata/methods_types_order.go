@@ -0,0 +1,18 @@
+package testdata
+
+type (
+	MethodsTypesOrderB struct{}
+
+	//-
+
+	MethodsTypesOrderA struct{}
+	methodsTypesOrderC struct{}
+)
+
+func (MethodsTypesOrderB) F() {}
+func (MethodsTypesOrderB) G() {}
+
+func (MethodsTypesOrderA) F() {}
+func (MethodsTypesOrderA) a() {}
+
+func (methodsTypesOrderC) F() {}
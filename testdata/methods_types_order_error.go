@@ -0,0 +1,13 @@
+package testdata
+
+type (
+	MethodsTypesOrderErrorB struct{}
+
+	//-
+
+	MethodsTypesOrderErrorA struct{}
+)
+
+func (MethodsTypesOrderErrorA) F() {}
+
+func (MethodsTypesOrderErrorB) F() {}
@@ -0,0 +1,12 @@
+package testdata
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"fmt"
+)
+
+func NitpickerCgo() {
+	fmt.Println(C.int(0))
+}
@@ -0,0 +1,21 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+type (
+	OutlineA struct{}
+	//-
+	OutlineB struct{}
+)
+
+func OutlineFunc() {
+	fmt.Println(OutlineA{}, OutlineB{})
+}
+
+const (
+	OutlineConst = 1
+)
+
+func (*OutlineA) Method() {}
@@ -0,0 +1,3 @@
+package packages
+
+func helper() {}
@@ -0,0 +1,9 @@
+//go:build ignore
+// +build ignore
+
+package packages
+
+type (
+	IgnoredA struct{}
+	IgnoredB struct{}
+)
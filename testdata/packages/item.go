@@ -0,0 +1,5 @@
+package packages
+
+type (
+	Product struct{}
+)
@@ -0,0 +1,7 @@
+//nolint:nit
+package packages
+
+type (
+	NoLintA struct{}
+	NoLintB struct{}
+)
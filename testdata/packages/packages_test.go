@@ -0,0 +1,6 @@
+package packages
+
+type (
+	internalTest struct{}
+	TestOnly     struct{}
+)
@@ -0,0 +1,6 @@
+package packages
+
+type (
+	Account struct{}
+	Order   struct{}
+)
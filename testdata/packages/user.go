@@ -0,0 +1,7 @@
+package packages
+
+type (
+	User struct{}
+
+	userID string
+)
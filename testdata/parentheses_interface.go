@@ -0,0 +1,7 @@
+package testdata
+
+type ParenthesesInterface interface {
+	B()
+	//-
+	A()
+}
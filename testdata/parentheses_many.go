@@ -0,0 +1,9 @@
+package testdata
+
+type ParenthesesManyA struct{}
+
+type ParenthesesManyB struct{}
+
+var ParenthesesManyC = ParenthesesManyA{}
+
+var ParenthesesManyD = ParenthesesManyB{}
@@ -0,0 +1,14 @@
+package testdata
+
+import "fmt"
+
+type ParenthesesSingle struct{}
+
+const ParenthesesSingleA = 1
+
+const (
+	// ParenthesesSingleB includes a comment, it is not unparenthesized.
+	ParenthesesSingleB = 2
+)
+
+var parenthesesSingle = fmt.Sprintf("%d", ParenthesesSingleA) // trailing comment
@@ -0,0 +1,7 @@
+package testdata
+
+type ParenthesesStruct struct {
+	B int
+	//-
+	A int
+}
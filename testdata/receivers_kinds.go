@@ -0,0 +1,9 @@
+package testdata
+
+type (
+	ReceiversKinds struct{}
+)
+
+func (r *ReceiversKinds) A() {}
+
+func (r ReceiversKinds) String() string { return "" }
@@ -0,0 +1,9 @@
+package testdata
+
+type (
+	ReceiversNames struct{}
+)
+
+func (r *ReceiversNames) A() {}
+
+func (n *ReceiversNames) B() {}
@@ -0,0 +1,7 @@
+package testdata
+
+type (
+	ReceiversSelf struct{}
+)
+
+func (self *ReceiversSelf) A() {}
@@ -0,0 +1,11 @@
+package testdata
+
+type (
+	ReceiversValid struct{}
+)
+
+func (r *ReceiversValid) A() {}
+
+func (*ReceiversValid) B() {}
+
+func (r *ReceiversValid) C() {}
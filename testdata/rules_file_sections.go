@@ -0,0 +1,9 @@
+package testdata
+
+const (
+	RulesConst = 1
+)
+
+type (
+	RulesType struct{}
+)
@@ -0,0 +1,9 @@
+package testdata
+
+import (
+	"testing"
+)
+
+func TestRulesB(t *testing.T) {}
+
+func TestRulesA(t *testing.T) {}
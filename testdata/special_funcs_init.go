@@ -0,0 +1,5 @@
+package testdata
+
+func SpecialFuncsInit() {}
+
+func init() {}
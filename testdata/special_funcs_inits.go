@@ -0,0 +1,5 @@
+package testdata
+
+func init() {}
+
+func init() {}
@@ -0,0 +1,5 @@
+package testdata
+
+func main() {}
+
+func SpecialFuncsMain() {}
@@ -0,0 +1,9 @@
+package testdata
+
+func init() {}
+
+func SpecialFuncsValid() {}
+
+func specialFuncsValid() {}
+
+func main() {}
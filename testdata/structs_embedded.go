@@ -0,0 +1,12 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+type (
+	StructsEmbedded struct {
+		Name string
+		fmt.Stringer
+	}
+)
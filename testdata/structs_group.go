@@ -0,0 +1,8 @@
+package testdata
+
+type (
+	StructsGroup struct {
+		name string
+		Age  int
+	}
+)
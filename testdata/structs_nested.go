@@ -0,0 +1,10 @@
+package testdata
+
+type (
+	StructsNested struct {
+		Items []struct {
+			id   int
+			Name string
+		}
+	}
+)
@@ -0,0 +1,8 @@
+package testdata
+
+type (
+	StructsSorted struct {
+		Name string
+		Age  int
+	}
+)
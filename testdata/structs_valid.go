@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"fmt"
+)
+
+type (
+	StructsValid struct {
+		fmt.Stringer
+		error
+
+		Name    string
+		Options []struct {
+			Key   string
+			Value string
+			//-
+			raw []byte
+		}
+		//-
+		count int
+		items map[string]*struct {
+			ID   int
+			name string
+		}
+	}
+
+	StructsValidEmpty struct{}
+)
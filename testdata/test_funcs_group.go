@@ -0,0 +1,9 @@
+package testdata
+
+import (
+	"testing"
+)
+
+func BenchmarkFuncsGroup(b *testing.B) {}
+
+func TestFuncsGroup(t *testing.T) {}
@@ -0,0 +1,9 @@
+package testdata
+
+import (
+	"testing"
+)
+
+func newTestFuncsHelper() {}
+
+func ExampleFuncsHelper() {}
@@ -0,0 +1,8 @@
+package testdata
+
+import (
+	"testing"
+)
+
+func TestFuncsSortedB(t *testing.T) {}
+func TestFuncsSortedA(t *testing.T) {}
@@ -0,0 +1,24 @@
+package testdata
+
+import (
+	"testing"
+)
+
+func TestFuncsValidA(t *testing.T) {}
+func TestFuncsValidB(t *testing.T) {}
+
+//-
+
+func TestFuncsValid(t *testing.T) {}
+
+func BenchmarkFuncsValid(b *testing.B) {}
+
+func FuzzFuncsValid(f *testing.F) {}
+
+func Example() {}
+
+func ExampleFuncsValid_suffix() {}
+
+func Testing() {}
+
+func newTestFuncsValid() {}
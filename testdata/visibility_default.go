@@ -0,0 +1,6 @@
+package testdata
+
+type (
+	ÄVisibility struct{}
+	aVisibility struct{}
+)
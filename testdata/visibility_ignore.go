@@ -0,0 +1,11 @@
+package testdata
+
+type (
+	VisibilityIgnoreA struct{}
+	visibilityIgnoreB struct{}
+	VisibilityIgnoreC struct{}
+)
+
+func visibilityIgnoreFuncA() {}
+
+func VisibilityIgnoreFuncB() {}
@@ -0,0 +1,28 @@
+package testdata
+
+type (
+	visibilityHelper   struct{}
+	VisibilityExported struct{}
+)
+
+const (
+	visibilityConst         = 1
+	VisibilityExportedConst = 2
+)
+
+var (
+	visibilityVar         = 1
+	VisibilityExportedVar = 2
+)
+
+func visibilityFunc() {}
+
+func VisibilityFunc() {}
+
+func (visibilityHelper) help() {}
+
+func (visibilityHelper) Help() {}
+
+func (VisibilityExported) do() {}
+
+func (VisibilityExported) Do() {}
@@ -12,6 +12,13 @@ type (
 	// the `type` sections.
 	TypesValidator struct {
 		sortedNamesValidator
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		// ParenthesesThreshold is the `type` value of ParenthesesThresholds.
+		ParenthesesThreshold int
+		// Visibility defines how exported and unexported names are grouped.
+		Visibility VisibilityPolicy
+		//-
 		comments *BreakComments
 		types    []string
 	}
@@ -32,14 +39,16 @@ func (tv *TypesValidator) Types() []string {
 
 // Validate makes sure the implemented `type` declaration satisfies the
 // following rules:
-// * Group declaration is parenthesized
+// * Group declaration is parenthesized, unless ParenthesesThreshold allows it
 // * Sorted exported types are declared first, and
 // * Sorted unexported types are declared next
 func (tv *TypesValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error { //nolint: gocyclo
 	tv.identType = "Type"
+	tv.collation = tv.Collation
+	tv.visibility = tv.Visibility
 
-	if !v.Lparen.IsValid() {
-		return errors.Wrap(errors.New("expected parenthesized declaration"), fset.PositionFor(v.Pos(), false).String())
+	if err := validateParentheses(v, tv.ParenthesesThreshold, fset); err != nil {
+		return err
 	}
 
 	for _, t := range v.Specs {
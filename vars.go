@@ -12,22 +12,41 @@ type (
 	// the `var` sections.
 	VarsValidator struct {
 		sortedNamesValidator
+		// Collation defines the comparison used for sorting names.
+		Collation Collation
+		// DeclarationOrder indicates names must not be used for initializing
+		// names declared before them in the same block.
+		DeclarationOrder bool
+		// DependencyOrder indicates names initialized using names declared
+		// before them in the same block are not required to be sorted.
+		DependencyOrder bool
+		// ParenthesesThreshold is the `var` value of ParenthesesThresholds.
+		ParenthesesThreshold int
+		// Visibility defines how exported and unexported names are grouped.
+		Visibility VisibilityPolicy
 	}
 )
 
 // Validate makes sure the implemented `var` declaration satisfies the
 // following rules:
-// * Group declaration is parenthesized
-// * Sorted exported vars are declared first, and
-// * Sorted unexported vars are declared next
+// * Group declaration is parenthesized, unless ParenthesesThreshold allows it
+// * Sorted exported vars are declared first,
+// * Sorted unexported vars are declared next, and
+// * Declarations follow DependencyOrder and DeclarationOrder when set.
 func (c *VarsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error { //nolint: gocyclo
 	c.identType = "Var"
+	c.collation = c.Collation
+	c.visibility = c.Visibility
+	c.declarations = c.DeclarationOrder
+	c.dependencies = c.DependencyOrder
 
-	if !v.Lparen.IsValid() {
-		return errors.Wrap(errors.New("expected parenthesized declaration"), fset.PositionFor(v.Pos(), false).String())
+	if err := validateParentheses(v, c.ParenthesesThreshold, fset); err != nil {
+		return err
 	}
 
-	for _, t := range v.Specs {
+	declared := declaredNames(v)
+
+	for i, t := range v.Specs {
 		errPrefix := fset.PositionFor(t.Pos(), false).String()
 
 		s, ok := t.(*ast.ValueSpec)
@@ -35,10 +54,8 @@ func (c *VarsValidator) Validate(v *ast.GenDecl, fset *token.FileSet) error { //
 			return errors.Wrap(errors.Errorf("invalid token %+v", t), errPrefix)
 		}
 
-		for _, name := range s.Names {
-			if err := c.validateName(errPrefix, name); err != nil {
-				return err
-			}
+		if err := c.validateValueSpec(s, i, declared, fset); err != nil {
+			return err
 		}
 	}
 
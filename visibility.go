@@ -0,0 +1,74 @@
+package nit
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// VisibilityPolicy represents how exported and unexported names are
+	// grouped in a section.
+	VisibilityPolicy uint8
+)
+
+const (
+	// VisibilityPolicyExportedFirst indicates exported names are declared
+	// first, then unexported ones.
+	VisibilityPolicyExportedFirst VisibilityPolicy = iota
+
+	// VisibilityPolicyUnexportedFirst indicates unexported names are declared
+	// first, then exported ones.
+	VisibilityPolicyUnexportedFirst
+
+	// VisibilityPolicyIgnore indicates exported and unexported names are not
+	// grouped, they are sorted by name only.
+	VisibilityPolicyIgnore
+)
+
+// NewVisibilityPolicies returns the policies defined in the received comma
+// separated value, using the format `section=policy`, where section is one of
+// types, consts, vars, funcs or methods; and policy one of exported-first,
+// unexported-first or ignore. Sections not included use exported-first.
+func NewVisibilityPolicies(s string) (map[FileSection]VisibilityPolicy, error) {
+	sections := map[string]FileSection{
+		"consts":  FileSectionConsts,
+		"funcs":   FileSectionFuncs,
+		"methods": FileSectionMethods,
+		"types":   FileSectionTypes,
+		"vars":    FileSectionVars,
+	}
+
+	policies := map[string]VisibilityPolicy{
+		"exported-first":   VisibilityPolicyExportedFirst,
+		"ignore":           VisibilityPolicyIgnore,
+		"unexported-first": VisibilityPolicyUnexportedFirst,
+	}
+
+	res := make(map[FileSection]VisibilityPolicy)
+
+	if s == "" {
+		return res, nil
+	}
+
+	for _, value := range strings.Split(s, ",") {
+		values := strings.SplitN(strings.TrimSpace(value), "=", 2)
+		if len(values) != 2 {
+			return nil, errors.Errorf("invalid visibility policy: %s", value)
+		}
+
+		section, ok := sections[values[0]]
+		if !ok {
+			return nil, errors.Errorf("invalid visibility policy section: %s", values[0])
+		}
+
+		policy, ok := policies[values[1]]
+		if !ok {
+			return nil, errors.Errorf("invalid visibility policy value: %s", values[1])
+		}
+
+		res[section] = policy
+	}
+
+	return res, nil
+}
@@ -0,0 +1,135 @@
+package nit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/nit"
+)
+
+func TestNewVisibilityPolicies(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		input         string
+		expected      map[nit.FileSection]nit.VisibilityPolicy
+		expectedError bool
+	}{
+		{
+			"OK: default",
+			"",
+			map[nit.FileSection]nit.VisibilityPolicy{},
+			false,
+		},
+		{
+			"OK",
+			"types=unexported-first, funcs=ignore,methods=exported-first",
+			map[nit.FileSection]nit.VisibilityPolicy{
+				nit.FileSectionTypes:   nit.VisibilityPolicyUnexportedFirst,
+				nit.FileSectionFuncs:   nit.VisibilityPolicyIgnore,
+				nit.FileSectionMethods: nit.VisibilityPolicyExportedFirst,
+			},
+			false,
+		},
+		{
+			"Error: format",
+			"types",
+			nil,
+			true,
+		},
+		{
+			"Error: section",
+			"imports=ignore",
+			nil,
+			true,
+		},
+		{
+			"Error: policy",
+			"types=mixed",
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			actual, err := nit.NewVisibilityPolicies(tt.input)
+			if (err != nil) != tt.expectedError {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+
+			if !cmp.Equal(tt.expected, actual) {
+				ts.Fatalf("expected values do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+func TestNitpicker_ValidateVisibility(t *testing.T) {
+	tests := [...]struct {
+		name          string
+		filename      string
+		policies      string
+		collation     nit.Collation
+		expectedError bool
+	}{
+		{
+			"OK: unexported first",
+			"visibility_unexported.go",
+			"types=unexported-first,consts=unexported-first,vars=unexported-first,funcs=unexported-first,methods=unexported-first",
+			nit.CollationBytewise,
+			false,
+		},
+		{
+			"OK: ignore",
+			"visibility_ignore.go",
+			"types=ignore,funcs=ignore",
+			nit.CollationCaseInsensitive,
+			false,
+		},
+		{
+			"Error: unexported first",
+			"visibility_unexported.go",
+			"",
+			nit.CollationBytewise,
+			true,
+		},
+		{
+			"Error: unexported first methods",
+			"visibility_unexported.go",
+			"types=unexported-first,consts=unexported-first,vars=unexported-first,funcs=unexported-first",
+			nit.CollationBytewise,
+			true,
+		},
+		{
+			"Error: default sorted across groups",
+			"visibility_default.go",
+			"",
+			nit.CollationBytewise,
+			true,
+		},
+		{
+			"Error: ignore",
+			"visibility_ignore.go",
+			"types=ignore",
+			nit.CollationCaseInsensitive,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(ts *testing.T) {
+			policies, err := nit.NewVisibilityPolicies(tt.policies)
+			if err != nil {
+				ts.Fatalf("expected no error, got %s", err)
+			}
+
+			n := nit.Nitpicker{Collation: tt.collation, VisibilityPolicies: policies}
+
+			if err := n.Validate(filepath.Join("testdata", tt.filename)); tt.expectedError != (err != nil) {
+				ts.Fatalf("expected error %t, got %s", tt.expectedError, err)
+			}
+		})
+	}
+}